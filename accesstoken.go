@@ -0,0 +1,129 @@
+package sumsub
+
+import (
+	"time"
+
+	"github.com/imroc/req"
+)
+
+// AccessToken is token for WebSDK and MobileSDK initialization
+type AccessToken struct {
+	Token  string `json:"token"`
+	UserID string `json:"userId"`
+
+	// Options token is generated with, they are used to refresh token
+	Options AccessTokenOptions `json:"-"`
+
+	// ExpiresAt is approximate time of the token expiration, it is zero if
+	// token is generated with default lifetime
+	ExpiresAt time.Time `json:"-"`
+}
+
+// Expired reports whether token lifetime is over
+func (t AccessToken) Expired() bool {
+	return !t.ExpiresAt.IsZero() && !time.Now().Before(t.ExpiresAt)
+}
+
+// AccessTokenOptions are parameters of the SDK access token
+type AccessTokenOptions struct {
+	// UserID is external user id of the applicant
+	UserID    string
+	LevelName string
+
+	// TTL is token lifetime, zero means default lifetime
+	TTL time.Duration
+
+	// ExternalActionID scopes token to the applicant action, e.g. payment
+	// method verification
+	ExternalActionID string
+
+	// ApplicantIdentifiers prefill applicant contacts
+	ApplicantIdentifiers *ApplicantIdentifiers
+}
+
+// ApplicantIdentifiers are contacts of the applicant passed with access token
+type ApplicantIdentifiers struct {
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// accessTokenRequest is body of the access token request
+type accessTokenRequest struct {
+	UserID               string                `json:"userId"`
+	LevelName            string                `json:"levelName"`
+	TTLInSecs            int                   `json:"ttlInSecs,omitempty"`
+	ExternalActionID     string                `json:"externalActionId,omitempty"`
+	ApplicantIdentifiers *ApplicantIdentifiers `json:"applicantIdentifiers,omitempty"`
+}
+
+// GenerateAccessToken for the SDK, userID is external user id of the applicant,
+// zero ttl means default lifetime
+// POST /resources/accessTokens?userId=&levelName=&ttlInSecs=
+func (s *SumSub) GenerateAccessToken(userID, levelName string, ttl time.Duration) (token AccessToken, err error) {
+	query := req.QueryParam{
+		"userId":    userID,
+		"levelName": levelName,
+	}
+	if ttl > 0 {
+		query["ttlInSecs"] = int(ttl.Seconds())
+	}
+
+	resp, err := s.do("POST", "resources/accessTokens", query)
+	if err := handleResponse(resp, err); err != nil {
+		return token, err
+	}
+
+	if err := s.decode(resp, &token); err != nil {
+		return token, err
+	}
+
+	token.setOptions(AccessTokenOptions{UserID: userID, LevelName: levelName, TTL: ttl})
+	return token, nil
+}
+
+// GenerateAccessTokenWithOptions for the SDK, it allows to scope token to the
+// applicant action and prefill applicant contacts
+// POST /resources/accessTokens/sdk
+func (s *SumSub) GenerateAccessTokenWithOptions(opts AccessTokenOptions) (token AccessToken, err error) {
+	body := accessTokenRequest{
+		UserID:               opts.UserID,
+		LevelName:            opts.LevelName,
+		TTLInSecs:            int(opts.TTL.Seconds()),
+		ExternalActionID:     opts.ExternalActionID,
+		ApplicantIdentifiers: opts.ApplicantIdentifiers,
+	}
+
+	resp, err := s.do("POST", "resources/accessTokens/sdk", req.BodyJSON(body))
+	if err := handleResponse(resp, err); err != nil {
+		return token, err
+	}
+
+	if err := s.decode(resp, &token); err != nil {
+		return token, err
+	}
+
+	token.setOptions(opts)
+	return token, nil
+}
+
+// RefreshAccessToken generates new token with the same options, it is used in
+// SDK expiration handler
+func (s *SumSub) RefreshAccessToken(token AccessToken) (AccessToken, error) {
+	if token.Options.ExternalActionID != "" || token.Options.ApplicantIdentifiers != nil {
+		return s.GenerateAccessTokenWithOptions(token.Options)
+	}
+
+	opts := token.Options
+	if opts.UserID == "" {
+		opts.UserID = token.UserID
+	}
+
+	return s.GenerateAccessToken(opts.UserID, opts.LevelName, opts.TTL)
+}
+
+func (t *AccessToken) setOptions(opts AccessTokenOptions) {
+	t.Options = opts
+	if opts.TTL > 0 {
+		t.ExpiresAt = time.Now().Add(opts.TTL)
+	}
+}
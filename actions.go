@@ -0,0 +1,199 @@
+package sumsub
+
+import (
+	"net/url"
+
+	"github.com/imroc/req"
+)
+
+// ApplicantAction is check performed for existing applicant, e.g. payment
+// method verification or face authentication
+type ApplicantAction struct {
+	ID               string          `json:"id"`
+	ApplicantID      string          `json:"applicantId"`
+	ExternalActionID string          `json:"externalActionId"`
+	Type             string          `json:"type"`
+	LevelName        string          `json:"levelName,omitempty"`
+	CreatedAt        Time            `json:"createdAt"`
+	Review           ApplicantReview `json:"review"`
+
+	PaymentMethod *PaymentMethod `json:"paymentMethod,omitempty"`
+}
+
+// GetApplicantActionByExternalID returns action by id assigned by the client
+// GET /resources/applicantActions/-;externalActionId={externalActionId}/one
+func (s *SumSub) GetApplicantActionByExternalID(externalActionID string) (action ApplicantAction, err error) {
+	resp, err := s.do("GET", "resources/applicantActions/-;externalActionId="+url.PathEscape(externalActionID)+"/one")
+	if err := handleResponse(resp, err); err != nil {
+		return action, err
+	}
+
+	err = s.decode(resp, &action)
+	return
+}
+
+// GetApplicantAction by action id
+// GET /resources/applicantActions/{actionId}/one
+func (s *SumSub) GetApplicantAction(actionID string) (action ApplicantAction, err error) {
+	resp, err := s.do("GET", "resources/applicantActions/"+url.PathEscape(actionID)+"/one")
+	if err := handleResponse(resp, err); err != nil {
+		return action, err
+	}
+
+	err = s.decode(resp, &action)
+	return
+}
+
+// GetWebhookAction fetches action the webhook is sent for, e.g. to get payment
+// method data of the reviewed action
+func (s *SumSub) GetWebhookAction(w *ApplicantActionWebhook) (ApplicantAction, error) {
+	if w.ApplicantActionID == "" {
+		return s.GetApplicantActionByExternalID(w.ExternalApplicantActionID)
+	}
+
+	return s.GetApplicantAction(w.ApplicantActionID)
+}
+
+// ApplicantActionRequest is data of the created action
+type ApplicantActionRequest struct {
+	// ExternalActionID is unique id of the action assigned by the client
+	ExternalActionID string `json:"externalActionId"`
+
+	// PaymentMethod is verified payment method, e.g. bank card
+	PaymentMethod *PaymentMethod `json:"paymentMethod,omitempty"`
+}
+
+// CreateApplicantAction for the applicant, levelName is action level
+// configured in the dashboard
+// POST /resources/applicantActions/-/forApplicant/{applicantId}?levelName=
+func (s *SumSub) CreateApplicantAction(id, levelName string, data ApplicantActionRequest) (action ApplicantAction, err error) {
+	resp, err := s.do("POST", "resources/applicantActions/-/forApplicant/"+url.PathEscape(id), req.QueryParam{"levelName": levelName}, req.BodyJSON(data))
+	if err := handleResponse(resp, err); err != nil {
+		return action, err
+	}
+
+	err = s.decode(resp, &action)
+	return
+}
+
+// Status returns review status of the action in the form of applicant status
+func (action ApplicantAction) Status() ApplicantStatus {
+	return ApplicantStatus{
+		ID:           action.ID,
+		ApplicantID:  action.ApplicantID,
+		CreateDate:   action.Review.CreateDate,
+		ReviewResult: action.Review.ReviewResult,
+		ReviewStatus: action.Review.ReviewStatus,
+
+		NotificationFailureCnt: action.Review.NotificationFailureCnt,
+	}
+}
+
+// IsCompleted reports whether action review is completed
+func (action ApplicantAction) IsCompleted() bool {
+	return action.Status().IsCompleted()
+}
+
+// IsPass returns moderation comment and true if action is approved
+func (action ApplicantAction) IsPass() (string, bool) {
+	return action.Status().IsPass()
+}
+
+// GetApplicantActionStatus returns review status of the action
+// GET /resources/applicantActions/{actionId}/one
+func (s *SumSub) GetApplicantActionStatus(actionID string) (ApplicantStatus, error) {
+	action, err := s.GetApplicantAction(actionID)
+	if err != nil {
+		return ApplicantStatus{}, err
+	}
+
+	return action.Status(), nil
+}
+
+// actionsPageSize is count of actions requested per page by
+// AllApplicantActions
+const actionsPageSize = 100
+
+// ListApplicantActions returns actions page of the applicant and total count
+// of the actions
+// GET /resources/applicantActions/-/forApplicant/{applicantId}?offset=&limit=
+func (s *SumSub) ListApplicantActions(id string, offset, limit int) (items []ApplicantAction, total int, err error) {
+	resp, err := s.do("GET", "resources/applicantActions/-/forApplicant/"+url.PathEscape(id), req.QueryParam{"offset": offset, "limit": limit})
+	if err := handleResponse(resp, err); err != nil {
+		return nil, 0, err
+	}
+
+	var list struct {
+		List struct {
+			Items      []ApplicantAction `json:"items"`
+			TotalItems int               `json:"totalItems"`
+		} `json:"list"`
+	}
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
+		return nil, 0, err
+	}
+
+	return list.List.Items, list.List.TotalItems, err
+}
+
+// ActionFilter selects actions by type and review status, empty fields match
+// any value
+type ActionFilter struct {
+	Type         string
+	ReviewStatus string
+}
+
+// Match reports whether action satisfies the filter
+func (f ActionFilter) Match(action ApplicantAction) bool {
+	return (f.Type == "" || action.Type == f.Type) &&
+		(f.ReviewStatus == "" || action.Review.ReviewStatus == f.ReviewStatus)
+}
+
+// Filter returns actions satisfying the filter
+func (f ActionFilter) Filter(actions []ApplicantAction) []ApplicantAction {
+	var filtered []ApplicantAction
+	for _, action := range actions {
+		if f.Match(action) {
+			filtered = append(filtered, action)
+		}
+	}
+
+	return filtered
+}
+
+// AllApplicantActions walks through all pages of the applicant actions and
+// returns those satisfying the filter
+func (s *SumSub) AllApplicantActions(id string, filter ActionFilter) ([]ApplicantAction, error) {
+	var actions []ApplicantAction
+	for offset := 0; ; offset += actionsPageSize {
+		items, total, err := s.ListApplicantActions(id, offset, actionsPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, filter.Filter(items)...)
+
+		if len(items) == 0 || offset+len(items) >= total {
+			break
+		}
+	}
+
+	return actions, nil
+}
+
+// UploadActionDocument attaches document to the applicant action, e.g. photo
+// of the bank card for payment method verification
+// POST /resources/applicantActions/{actionId}/images
+func (s *SumSub) UploadActionDocument(actionID string, doc Document) (DocumentResult, error) {
+	return s.upload("resources/applicantActions/"+url.PathEscape(actionID)+"/images", actionID, doc)
+}
+
+// RequestActionCheck moves applicant action to pending review, result is sent
+// with applicantActionReviewed webhook or can be polled with
+// GetApplicantActionStatus
+// POST /resources/applicantActions/{actionId}/review/requestCheck
+func (s *SumSub) RequestActionCheck(actionID string) error {
+	resp, err := s.do("POST", "resources/applicantActions/"+url.PathEscape(actionID)+"/review/requestCheck")
+	return handleResponse(resp, err)
+}
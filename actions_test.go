@@ -0,0 +1,74 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestApplicantActionStatus(t *testing.T) {
+	data := `{
+		"id": "action1",
+		"applicantId": "applicant1",
+		"externalActionId": "card-1",
+		"createdAt": "2021-05-01 10:00:00",
+		"review": {
+			"reviewStatus": "completed",
+			"reviewResult": {"reviewAnswer": "RED", "moderationComment": "card does not match"}
+		}
+	}`
+
+	var action ApplicantAction
+	if err := json.Unmarshal([]byte(data), &action); err != nil {
+		t.Fatal(err)
+	}
+
+	if !action.IsCompleted() {
+		t.Error("action should be completed")
+	}
+	if comment, pass := action.IsPass(); pass || comment != "card does not match" {
+		t.Error("wrong pass result", comment, pass)
+	}
+	if status := action.Status(); status.ApplicantID != "applicant1" || status.ID != "action1" {
+		t.Errorf("wrong status %+v", status)
+	}
+}
+
+func TestActionFilter(t *testing.T) {
+	actions := []ApplicantAction{
+		{ID: "1", Type: "paymentMethod", Review: ApplicantReview{ReviewStatus: ReviewStatusCompleted}},
+		{ID: "2", Type: "paymentMethod", Review: ApplicantReview{ReviewStatus: ReviewStatusPending}},
+		{ID: "3", Type: "faceAuth", Review: ApplicantReview{ReviewStatus: ReviewStatusCompleted}},
+	}
+
+	filtered := ActionFilter{Type: "paymentMethod", ReviewStatus: ReviewStatusCompleted}.Filter(actions)
+	if len(filtered) != 1 || filtered[0].ID != "1" {
+		t.Errorf("wrong filtered actions %+v", filtered)
+	}
+
+	if all := (ActionFilter{}).Filter(actions); len(all) != 3 {
+		t.Error("empty filter should match all actions")
+	}
+}
+
+func TestApplicantActionPathEscape(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Write([]byte(`{"id": "action1"}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+
+	if _, err := s.GetApplicantActionByExternalID("card/1;x"); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/resources/applicantActions/-;externalActionId=card%2F1%3Bx/one" {
+		t.Error("wrong path", path)
+	}
+}
@@ -0,0 +1,158 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/imroc/req"
+)
+
+// AMLCheck is result of the AML screening of the applicant
+type AMLCheck struct {
+	Check
+	AMLCheckInfo AMLCheckInfo `json:"amlCheckInfo"`
+}
+
+// AMLCheckInfo is screening summary with found hits
+type AMLCheckInfo struct {
+	// Hits is count of the found matches, details are in HitsData
+	Hits     int     `json:"hits"`
+	HitsData AMLHits `json:"hitsData,omitempty"`
+}
+
+// categories of the AML hits
+const (
+	AMLCategorySanctions      = "sanctions"
+	AMLCategoryPEP            = "pep"
+	AMLCategoryAdverseMedia   = "adverse-media"
+	AMLCategoryWarnings       = "warnings"
+	AMLCategoryFitnessProbity = "fitness-probity"
+)
+
+// AMLHit is matched entity of the sanctions, PEP or adverse media lists
+type AMLHit struct {
+	ID string `json:"id"`
+
+	// Resolution is AMLHitTrueMatch or AMLHitFalsePositive if hit is resolved
+	Resolution string `json:"resolution,omitempty"`
+
+	// Name of the matched entity
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+
+	// MatchScore is similarity of the applicant and the entity from 0 to 1
+	MatchScore float64 `json:"matchScore"`
+
+	Categories []string    `json:"categories,omitempty"`
+	Sources    []AMLSource `json:"sources,omitempty"`
+
+	// DateOfBirth and Countries of the matched entity
+	DateOfBirth []Date   `json:"dob,omitempty"`
+	Countries   []string `json:"countries,omitempty"`
+
+	// MatchDetails are fields of the applicant matched with the entity
+	MatchDetails json.RawMessage `json:"matchDetails,omitempty"`
+}
+
+// HasCategory reports whether hit belongs to the category
+func (hit AMLHit) HasCategory(category string) bool {
+	return containsString(hit.Categories, category)
+}
+
+// AMLSource is list the entity is found in
+type AMLSource struct {
+	Name     string `json:"name"`
+	URL      string `json:"url,omitempty"`
+	ListedAt Date   `json:"listedAt,omitempty"`
+}
+
+// AMLHits is list of the AML hits
+type AMLHits []AMLHit
+
+// ByCategory returns hits of the category
+func (hits AMLHits) ByCategory(category string) AMLHits {
+	var filtered AMLHits
+	for _, hit := range hits {
+		if hit.HasCategory(category) {
+			filtered = append(filtered, hit)
+		}
+	}
+
+	return filtered
+}
+
+// WithScoreAbove returns hits with match score above the threshold
+func (hits AMLHits) WithScoreAbove(score float64) AMLHits {
+	var filtered AMLHits
+	for _, hit := range hits {
+		if hit.MatchScore > score {
+			filtered = append(filtered, hit)
+		}
+	}
+
+	return filtered
+}
+
+// GetAMLChecks returns latest AML screening checks of the applicant
+// GET /resources/checks/latest?type=AML&applicantId=
+func (s *SumSub) GetAMLChecks(id string) ([]AMLCheck, error) {
+	checks, err := s.GetLatestChecks(id, CheckTypeAML)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]AMLCheck, len(checks))
+	for i, check := range checks {
+		if err := json.Unmarshal(check.Data, &result[i]); err != nil {
+			return nil, err
+		}
+		result[i].Check = check
+	}
+
+	return result, nil
+}
+
+// SetOngoingMonitoring enables or disables continuous AML screening of the
+// applicant, new hits are sent with applicantAmlHitsFound webhook
+// PATCH /resources/applicants/{applicantId}/ongoingMonitoring
+func (s *SumSub) SetOngoingMonitoring(id string, enabled bool) error {
+	body := struct {
+		Enabled bool `json:"enabled"`
+	}{enabled}
+
+	resp, err := s.do("PATCH", "resources/applicants/"+id+"/ongoingMonitoring", req.BodyJSON(body))
+	return handleResponse(resp, err)
+}
+
+// resolutions of the AML hits
+const (
+	AMLHitTrueMatch     = "TRUE_POSITIVE"
+	AMLHitFalsePositive = "FALSE_POSITIVE"
+)
+
+// ResolveAMLHit marks hit of the check as true match or false positive with
+// comment of the analyst
+// POST /resources/checks/{checkId}/hits/{hitId}/resolve
+func (s *SumSub) ResolveAMLHit(checkID, hitID, resolution, comment string) error {
+	if resolution != AMLHitTrueMatch && resolution != AMLHitFalsePositive {
+		return errors.New("unknown hit resolution " + resolution)
+	}
+
+	body := struct {
+		Resolution string `json:"resolution"`
+		Comment    string `json:"comment,omitempty"`
+	}{resolution, comment}
+
+	resp, err := s.do("POST", "resources/checks/"+checkID+"/hits/"+hitID+"/resolve", req.BodyJSON(body))
+	return handleResponse(resp, err)
+}
+
+// ConfirmAMLHit marks hit as true match
+func (s *SumSub) ConfirmAMLHit(checkID, hitID, comment string) error {
+	return s.ResolveAMLHit(checkID, hitID, AMLHitTrueMatch, comment)
+}
+
+// DismissAMLHit marks hit as false positive
+func (s *SumSub) DismissAMLHit(checkID, hitID, comment string) error {
+	return s.ResolveAMLHit(checkID, hitID, AMLHitFalsePositive, comment)
+}
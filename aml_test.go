@@ -0,0 +1,45 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAMLHits(t *testing.T) {
+	data := `{
+		"answer": "RED",
+		"checkType": "AML",
+		"amlCheckInfo": {
+			"hits": 2,
+			"hitsData": [
+				{
+					"id": "h1",
+					"name": "John Smith",
+					"matchScore": 0.93,
+					"categories": ["sanctions", "pep"],
+					"sources": [{"name": "OFAC SDN", "listedAt": "2019-04-01"}],
+					"dob": ["1970-01-01"],
+					"countries": ["RUS"]
+				},
+				{"id": "h2", "name": "J. Smith", "matchScore": 0.4, "categories": ["adverse-media"]}
+			]
+		}
+	}`
+
+	var check AMLCheck
+	if err := json.Unmarshal([]byte(data), &check); err != nil {
+		t.Fatal(err)
+	}
+
+	hits := check.AMLCheckInfo.HitsData
+	if len(hits) != 2 || hits[0].Sources[0].Name != "OFAC SDN" || hits[0].DateOfBirth[0] != "1970-01-01" {
+		t.Fatalf("wrong hits %+v", hits)
+	}
+
+	if pep := hits.ByCategory(AMLCategoryPEP); len(pep) != 1 || pep[0].ID != "h1" {
+		t.Errorf("wrong pep hits %+v", pep)
+	}
+	if media := hits.ByCategory(AMLCategoryAdverseMedia).WithScoreAbove(0.5); len(media) != 0 {
+		t.Errorf("unexpected adverse media hits %+v", media)
+	}
+}
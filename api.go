@@ -0,0 +1,126 @@
+package sumsub
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// API is interface of the sumsub client, it is implemented by *SumSub and
+// sumsubmock.Client, so services can depend on it and be tested without
+// network access
+type API interface {
+	ActivateApplicant(id string) error
+	AddApplicantAgreement(id string, agreement Agreement) (Agreement, error)
+	AddApplicantNote(id string, text string) (Note, error)
+	AddApplicantTags(id string, tags ...string) error
+	AddBeneficiary(id string, b Beneficiary) (Beneficiary, error)
+	AddDocument(id string, metadata DocumentMetaData, file io.Reader) (DocumentResult, error)
+	AddDocumentBytes(id string, metadata DocumentMetaData, data []byte) (DocumentResult, error)
+	AddDocumentFromURL(id string, metadata DocumentMetaData, fileURL string, maxSize int64) (DocumentResult, error)
+	AddDocumentSides(id string, metadata DocumentMetaData, front io.Reader, back io.Reader) (DocumentResult, DocumentResult, error)
+	AddTransactionNote(id string, text string) (Note, error)
+	AddVideoSelfie(id string, metadata DocumentMetaData, video io.Reader, opts VideoOptions) (DocumentResult, error)
+	AllApplicantActions(id string, filter ActionFilter) ([]ApplicantAction, error)
+	ApplicantComplete(id string, data ApplicantCompleteRequest) error
+	BankCardResult(actionID string) (BankCardResult, error)
+	BlocklistApplicant(id string, note string) error
+	ConfirmAMLHit(checkID string, hitID string, comment string) error
+	ConfirmContact(id string, contact string, code string) error
+	CreateApplicant(a *Applicant) error
+	CreateApplicantAction(id string, levelName string, data ApplicantActionRequest) (ApplicantAction, error)
+	CreateApplicants(applicants []Applicant, concurrency int) []CreateResult
+	CreateCompanyApplicant(a *Applicant) error
+	CreateFaceAuthSession(userID string, levelName string, externalActionID string, ttl time.Duration) (FaceAuthSession, error)
+	DeactivateApplicant(id string) error
+	DeactivateDocumentImage(inspectionID string, imageID string) error
+	DismissAMLHit(checkID string, hitID string, comment string) error
+	Do(ctx context.Context, method string, urlpath string, query map[string]interface{}, body interface{}, out interface{}) error
+	DownloadApplicantImages(id string, concurrency int, fn func(DocumentImage) error) error
+	GenerateAccessToken(userID string, levelName string, ttl time.Duration) (AccessToken, error)
+	GenerateAccessTokenWithOptions(opts AccessTokenOptions) (AccessToken, error)
+	GenerateShareToken(applicantID string, forClientID string, ttl time.Duration) (ShareToken, error)
+	GenerateWebSDKLink(levelName string, opts WebSDKLinkOptions) (WebSDKLink, error)
+	GetAMLChecks(id string) ([]AMLCheck, error)
+	GetAPIUsage(from time.Time, to time.Time) ([]UsageCounter, error)
+	GetApplicant(id string) (Applicant, error)
+	GetApplicantAction(actionID string) (ApplicantAction, error)
+	GetApplicantActionByExternalID(externalActionID string) (ApplicantAction, error)
+	GetApplicantActionStatus(actionID string) (ApplicantStatus, error)
+	GetApplicantAgreements(id string) ([]Agreement, error)
+	GetApplicantDeletionStatus(id string) (DeletionStatus, error)
+	GetApplicantDocuments(id string) ([]DocumentResource, error)
+	GetApplicantEvents(id string, offset int, limit int) ([]ApplicantEvent, int, error)
+	GetApplicantNotes(id string) ([]Note, error)
+	GetApplicantOne(id string) (Applicant, error)
+	GetApplicantQuestionnaires(id string) ([]Questionnaire, error)
+	GetApplicantReport(id string, reportType string, lang string) ([]byte, error)
+	GetApplicantReviewHistory(id string) ([]ReviewAttempt, error)
+	GetApplicantStatus(id string) (ApplicantStatus, error)
+	GetApplicantStatuses(ctx context.Context, ids []string, concurrency int) map[string]StatusResult
+	GetApplicantTags(id string) ([]string, error)
+	GetAuditLog(from time.Time, to time.Time, offset int, limit int) ([]AuditEvent, int, error)
+	GetCompanyRegistryData(id string) ([]CompanyCheck, error)
+	GetCountryDocTypes(country string) ([]string, error)
+	GetDocumentImage(inspectionID string, imageID string) ([]byte, string, error)
+	GetFaceAuthResult(session FaceAuthSession) (ApplicantAction, error)
+	GetLatestChecks(id string, checkType string) ([]Check, error)
+	GetLevel(name string) (Level, error)
+	GetModerationStates(id string) ([]ModerationState, error)
+	GetOnboardingState(id string) (OnboardingState, error)
+	GetOwnershipTree(id string) (*OwnershipNode, error)
+	GetQuestionnaireDefinitions(levelName string) ([]QuestionnaireDefinition, error)
+	GetRequiredDocsStatus(id string) (map[string]*RequiredDocStatus, error)
+	GetSupportedDocTypes() (map[string][]string, error)
+	GetTransaction(id string) (TransactionResult, error)
+	GetTransactionByTxnID(txnID string) (TransactionResult, error)
+	GetTravelRuleStatus(id string) (string, error)
+	GetWebhookAction(w *ApplicantActionWebhook) (ApplicantAction, error)
+	Health() (HealthStatus, error)
+	ImportApplicant(shareToken string) (Applicant, error)
+	InvalidateApplicant(id string) error
+	InviteBeneficiaries(companyID string, levelName string, opts WebSDKLinkOptions) ([]BeneficiaryInvitation, error)
+	LinkApplicantToCompany(companyID string, applicantID string, types []string, shareSize float64) (Beneficiary, error)
+	ListApplicantActions(id string, offset int, limit int) ([]ApplicantAction, int, error)
+	ListApplicants(offset int, limit int) ([]Applicant, int, error)
+	ListLevels() ([]Level, error)
+	OutstandingBeneficiaries(companyID string) ([]Beneficiary, error)
+	Ping() error
+	RefreshAccessToken(token AccessToken) (AccessToken, error)
+	RemoveApplicantTags(id string, tags ...string) error
+	RemoveBeneficiary(id string, beneficiaryID string) error
+	RequestActionCheck(actionID string) error
+	RequestApplicantDeletion(id string) (DeletionStatus, error)
+	RescoreTransaction(id string) (TransactionResult, error)
+	ResendWebhook(id string) error
+	ResolveAMLHit(checkID string, hitID string, resolution string, comment string) error
+	ReviewReport(from time.Time, to time.Time) (*ReviewReport, error)
+	RotateDocumentImage(inspectionID string, imageID string, angle int) error
+	SendConfirmationCode(id string, contact string) error
+	SendTestWebhook(data TestWebhookRequest) error
+	SetApplicantPriority(id string, priority int) error
+	SetApplicantTags(id string, tags []string) error
+	SetOngoingMonitoring(id string, enabled bool) error
+	SetTransactionTags(id string, tags []string) error
+	SimulateApproval(id string) error
+	SimulateFinalRejection(id string, comment string, labels ...string) error
+	SimulateImageReviews(id string, images map[string]ImageReviewResult) error
+	SimulateRetry(id string, comment string, labels ...string) error
+	SubmitQuestionnaire(id string, q Questionnaire) error
+	SubmitTransaction(id string, txn Transaction) (TransactionResult, error)
+	SubmitTravelRuleTransfer(id string, t TravelRuleTransfer) (TransactionResult, error)
+	UpdateBeneficiary(id string, b Beneficiary) (Beneficiary, error)
+	UpdateCompanyInfo(id string, info CompanyInfo) (CompanyInfo, error)
+	UpdateFixedInfo(id string, info ApplicantInfo) (ApplicantInfo, error)
+	UpdateTransactionProps(id string, props map[string]string) error
+	UploadActionDocument(actionID string, doc Document) (DocumentResult, error)
+	UploadDocument(id string, doc Document) (DocumentResult, error)
+	VerifyBankCard(id string, levelName string, externalActionID string, card BankCard) (BankCardVerification, error)
+	WaitForReview(ctx context.Context, id string, opts PollOptions) (ApplicantStatus, error)
+	WalkAuditLog(from time.Time, to time.Time, fn func(AuditEvent) error) error
+	WhitelistApplicant(id string, note string) error
+	WriteApplicantReport(w io.Writer, id string, reportType string, lang string) error
+	WriteDocumentImage(w io.Writer, inspectionID string, imageID string) (string, error)
+}
+
+var _ API = (*SumSub)(nil)
@@ -0,0 +1,107 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/imroc/req"
+)
+
+// auditPageSize is count of events requested per page by WalkAuditLog
+const auditPageSize = 100
+
+// AuditEvent is record of the client account activity, e.g. level changed in
+// the dashboard or api secret created
+type AuditEvent struct {
+	ID          string         `json:"id"`
+	Activity    string         `json:"activity"`
+	CreatedAt   Time           `json:"createdAt"`
+	Initiator   EventInitiator `json:"initiator"`
+	IP          string         `json:"ip,omitempty"`
+	ApplicantID string         `json:"applicantId,omitempty"`
+
+	// Data is activity specific payload
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// auditQuery is query of the date range [from, to) page
+func auditQuery(from, to time.Time, offset, limit int) req.QueryParam {
+	return req.QueryParam{
+		"from":   from.UTC().Format(TimeLayout),
+		"to":     to.UTC().Format(TimeLayout),
+		"offset": offset,
+		"limit":  limit,
+	}
+}
+
+// GetAuditLog returns page of the account audit events in the date range
+// [from, to) and total count of the events
+// GET /resources/auditTrailEvents?from=&to=&offset=&limit=
+func (s *SumSub) GetAuditLog(from, to time.Time, offset, limit int) (events []AuditEvent, total int, err error) {
+	resp, err := s.do("GET", "resources/auditTrailEvents", auditQuery(from, to, offset, limit))
+	if err := handleResponse(resp, err); err != nil {
+		return nil, 0, err
+	}
+
+	var list struct {
+		List struct {
+			Items      []AuditEvent `json:"items"`
+			TotalItems int          `json:"totalItems"`
+		} `json:"list"`
+	}
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
+		return nil, 0, err
+	}
+
+	return list.List.Items, list.List.TotalItems, err
+}
+
+// WalkAuditLog calls fn for each audit event in the date range [from, to),
+// walking stops on the first fn error
+func (s *SumSub) WalkAuditLog(from, to time.Time, fn func(AuditEvent) error) error {
+	for offset := 0; ; offset += auditPageSize {
+		events, total, err := s.GetAuditLog(from, to, offset, auditPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+
+		if len(events) == 0 || offset+len(events) >= total {
+			return nil
+		}
+	}
+}
+
+// UsageCounter is count of the api requests of the day
+type UsageCounter struct {
+	Date     Date   `json:"date"`
+	Endpoint string `json:"endpoint"`
+	Count    int    `json:"count"`
+}
+
+// GetAPIUsage returns daily counters of the api requests in the date range
+// [from, to)
+// GET /resources/usage?from=&to=
+func (s *SumSub) GetAPIUsage(from, to time.Time) ([]UsageCounter, error) {
+	query := req.QueryParam{"from": NewDate(from.UTC()), "to": NewDate(to.UTC())}
+	resp, err := s.do("GET", "resources/usage", query)
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		Items []UsageCounter `json:"items"`
+	}
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return list.Items, err
+}
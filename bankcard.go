@@ -0,0 +1,144 @@
+package sumsub
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+// payment method types
+const (
+	PaymentMethodBankCard = "bankCard"
+)
+
+// PaymentMethod verified with applicant action
+type PaymentMethod struct {
+	Type string            `json:"type"`
+	Data PaymentMethodData `json:"data"`
+}
+
+// PaymentMethodData is declared data of the payment method
+type PaymentMethodData struct {
+	RequiredIDDoc PaymentMethodIDDoc `json:"requiredIdDoc"`
+}
+
+// PaymentMethodIDDoc is declared data of the payment method document, for
+// bank card Number is masked PAN, e.g. 411111******1111
+type PaymentMethodIDDoc struct {
+	Country   string `json:"country,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Number    string `json:"number,omitempty"`
+}
+
+// BankCard is bank card declared by the applicant
+type BankCard struct {
+	// MaskedPAN is card number with hidden middle digits, e.g. 411111******1111
+	MaskedPAN string
+
+	// Country is ISO 3166-1 alpha-3 code of the card issuer country
+	Country string
+
+	FirstName string
+	LastName  string
+
+	// Image is photo of the front side of the card
+	Image io.Reader
+}
+
+// BankCardVerification is bank card verification submitted to review
+type BankCardVerification struct {
+	Action ApplicantAction
+	Image  DocumentResult
+}
+
+// VerifyBankCard creates payment method action of the level, uploads card
+// image and requests check. Result is sent with applicantActionReviewed
+// webhook or can be checked with BankCardResult
+func (s *SumSub) VerifyBankCard(id, levelName, externalActionID string, card BankCard) (v BankCardVerification, err error) {
+	if !isMaskedPAN(card.MaskedPAN) {
+		return v, errors.New("invalid masked PAN " + card.MaskedPAN)
+	}
+
+	doc := PaymentMethodIDDoc{
+		Country:   card.Country,
+		FirstName: card.FirstName,
+		LastName:  card.LastName,
+		Number:    card.MaskedPAN,
+	}
+
+	v.Action, err = s.CreateApplicantAction(id, levelName, ApplicantActionRequest{
+		ExternalActionID: externalActionID,
+		PaymentMethod: &PaymentMethod{
+			Type: PaymentMethodBankCard,
+			Data: PaymentMethodData{RequiredIDDoc: doc},
+		},
+	})
+	if err != nil {
+		return v, err
+	}
+
+	v.Image, err = s.UploadActionDocument(v.Action.ID, Document{
+		Metadata: DocumentMetaData{
+			IDDocType: DocSetType_BANK_CARD,
+			Country:   card.Country,
+			FirstName: card.FirstName,
+			LastName:  card.LastName,
+			Number:    card.MaskedPAN,
+		},
+		Content: card.Image,
+	})
+	if err != nil {
+		return v, err
+	}
+
+	err = s.RequestActionCheck(v.Action.ID)
+	return
+}
+
+// BankCardResult is outcome of the bank card verification
+type BankCardResult struct {
+	Completed bool
+
+	// Match is true if card on the image matches declared masked PAN and
+	// holder, it is valid only for completed review
+	Match bool
+
+	MaskedPAN    string
+	ReviewResult ReviewResult
+}
+
+// BankCardResult returns result of the bank card verification action
+func (s *SumSub) BankCardResult(actionID string) (result BankCardResult, err error) {
+	action, err := s.GetApplicantAction(actionID)
+	if err != nil {
+		return result, err
+	}
+
+	result.Completed = action.IsCompleted()
+	_, result.Match = action.IsPass()
+	result.Match = result.Completed && result.Match
+	result.ReviewResult = action.Review.ReviewResult
+	if action.PaymentMethod != nil {
+		result.MaskedPAN = action.PaymentMethod.Data.RequiredIDDoc.Number
+	}
+
+	return result, nil
+}
+
+// isMaskedPAN checks that PAN has 12-19 characters of digits and mask symbols
+// with visible last digits, full card number is not accepted
+func isMaskedPAN(pan string) bool {
+	if len(pan) < 12 || len(pan) > 19 || !strings.ContainsAny(pan, "*xX") {
+		return false
+	}
+
+	for _, c := range pan {
+		if !strings.ContainsRune("0123456789*xX", c) {
+			return false
+		}
+	}
+
+	last := pan[len(pan)-4:]
+	return strings.Trim(last, "0123456789") == ""
+}
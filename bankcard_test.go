@@ -0,0 +1,18 @@
+package sumsub
+
+import "testing"
+
+func TestIsMaskedPAN(t *testing.T) {
+	for pan, valid := range map[string]bool{
+		"411111******1111": true,
+		"5555XXXXXXXX4444": true,
+		"4111111111111111": false,
+		"411111******":     false,
+		"4111-1111-1111":   false,
+		"4111":             false,
+	} {
+		if isMaskedPAN(pan) != valid {
+			t.Errorf("%s: expected %v", pan, valid)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package sumsub
+
+import (
+	"context"
+	"sync"
+)
+
+// CreateResult is outcome of the applicant creation in the batch
+type CreateResult struct {
+	Applicant Applicant
+	Err       error
+
+	// Duplicate is true if applicant with the same externalUserId exists
+	Duplicate bool
+}
+
+// CreateApplicants creates applicants with at most concurrency parallel
+// requests, results are returned in the same order as applicants
+func (s *SumSub) CreateApplicants(applicants []Applicant, concurrency int) []CreateResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]CreateResult, len(applicants))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := range applicants {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			a := applicants[i]
+			err := s.CreateApplicant(&a)
+
+			results[i] = CreateResult{
+				Applicant: a,
+				Err:       err,
+				Duplicate: IsConflict(err),
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// StatusResult is outcome of the applicant status request in the batch
+type StatusResult struct {
+	Status ApplicantStatus
+	Err    error
+}
+
+// GetApplicantStatuses requests statuses of the applicants with at most
+// concurrency parallel requests, results are mapped by applicant id. Requests
+// also wait for the rate limit of the client set by WithRateLimit, requests
+// which are not started when ctx is done fail with ctx error
+func (s *SumSub) GetApplicantStatuses(ctx context.Context, ids []string, concurrency int) map[string]StatusResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make(map[string]StatusResult, len(ids))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, id := range ids {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			results[id] = StatusResult{Err: ctx.Err()}
+			mu.Unlock()
+			continue
+		}
+		wg.Add(1)
+
+		go func(id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			status, err := s.getApplicantStatus(ctx, id)
+
+			mu.Lock()
+			results[id] = StatusResult{Status: status, Err: err}
+			mu.Unlock()
+		}(id)
+	}
+
+	wg.Wait()
+	return results
+}
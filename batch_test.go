@@ -0,0 +1,73 @@
+package sumsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetApplicantStatuses(t *testing.T) {
+	var active, maxActive int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			max := atomic.LoadInt32(&maxActive)
+			if n <= max || atomic.CompareAndSwapInt32(&maxActive, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		id := strings.Split(r.URL.Path, "/")[3]
+		if id == "unknown" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"description": "not found", "code": 404}`)
+			return
+		}
+		fmt.Fprintf(w, `{"applicantId": %q, "reviewStatus": "pending"}`, id)
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+
+	ids := []string{"id1", "id2", "id3", "id4", "id5", "unknown"}
+	results := s.GetApplicantStatuses(context.Background(), ids, 2)
+
+	if len(results) != len(ids) {
+		t.Fatal("wrong count of results", len(results))
+	}
+	for _, id := range ids[:5] {
+		if r := results[id]; r.Err != nil || r.Status.ApplicantID != id {
+			t.Errorf("%s: unexpected result %+v", id, r)
+		}
+	}
+	if e, ok := results["unknown"].Err.(*Error); !ok || e.Code != 404 {
+		t.Error("expected api error, got", results["unknown"].Err)
+	}
+	if max := atomic.LoadInt32(&maxActive); max > 2 {
+		t.Error("concurrency is exceeded", max)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for id, r := range s.GetApplicantStatuses(ctx, ids, 1) {
+		if r.Err == nil {
+			t.Error("expected error for cancelled context", id)
+		}
+	}
+
+	WithRateLimit(200, 1)(s)
+	start := time.Now()
+	s.GetApplicantStatuses(context.Background(), ids, len(ids))
+	if d := time.Since(start); d < 20*time.Millisecond {
+		t.Error("rate limit is not applied", d)
+	}
+}
@@ -0,0 +1,161 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Cache keeps applicant reads of the client, values are json encoded
+// responses. It can be implemented on top of Redis or memcached to share
+// cache between instances
+type Cache interface {
+	// Get returns value of the key, false is returned if key is missing or
+	// expired
+	Get(key string) ([]byte, bool, error)
+
+	// Set value of the key for ttl
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// Delete key from the cache
+	Delete(key string) error
+}
+
+// cache keys of the applicant reads
+const (
+	cacheKeyApplicant = "sumsub:applicant:"
+	cacheKeyStatus    = "sumsub:status:"
+)
+
+// WithCache option caches GetApplicant and GetApplicantStatus responses for
+// ttl, nil cache means in-memory cache, ttl <= 0 disables caching. Cached
+// applicant is dropped when the client changes it, use
+// WebhookHandler.InvalidateCache to drop it on changes made by sumsub
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(s *SumSub) {
+		if ttl <= 0 {
+			s.cache = nil
+			return
+		}
+		if cache == nil {
+			cache = NewMemoryCache()
+		}
+
+		s.cache = cache
+		s.cacheTTL = ttl
+	}
+}
+
+// InvalidateApplicant drops cached reads of the applicant
+func (s *SumSub) InvalidateApplicant(id string) error {
+	if s.cache == nil || id == "" {
+		return nil
+	}
+
+	if err := s.cache.Delete(cacheKeyApplicant + id); err != nil {
+		return err
+	}
+
+	return s.cache.Delete(cacheKeyStatus + id)
+}
+
+// cached decodes cached value of the key into v, false is returned if cache
+// is disabled or value is missing
+func (s *SumSub) cached(key string, v interface{}) bool {
+	if s.cache == nil {
+		return false
+	}
+
+	data, ok, err := s.cache.Get(key)
+	if err != nil {
+		log.Warningf("cache %s: %v", key, err)
+		return false
+	}
+	if !ok {
+		return false
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		log.Warningf("cache %s: %v", key, err)
+		return false
+	}
+
+	return true
+}
+
+// setCached encodes v and stores it in the cache
+func (s *SumSub) setCached(key string, v interface{}) {
+	if s.cache == nil {
+		return
+	}
+
+	data, err := json.Marshal(v)
+	if err == nil {
+		err = s.cache.Set(key, data, s.cacheTTL)
+	}
+	if err != nil {
+		log.Warningf("cache %s: %v", key, err)
+	}
+}
+
+// InvalidateCache makes handler drop cached reads of the applicant in the
+// client before callbacks are called for any webhook of the applicant
+func (h *WebhookHandler) InvalidateCache(s *SumSub) {
+	h.cached = s
+}
+
+// MemoryCache is in-memory Cache
+type MemoryCache struct {
+	mu     sync.Mutex
+	values map[string]cacheValue
+}
+
+type cacheValue struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates empty in-memory cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		values: make(map[string]cacheValue),
+	}
+}
+
+// Get value of the key if it is not expired
+func (c *MemoryCache) Get(key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.values[key]
+	if !ok || !time.Now().Before(v.expires) {
+		return nil, false, nil
+	}
+
+	return v.data, true, nil
+}
+
+// Set value of the key, expired values are removed
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range c.values {
+		if !now.Before(v.expires) {
+			delete(c.values, k)
+		}
+	}
+
+	c.values[key] = cacheValue{data: value, expires: now.Add(ttl)}
+	return nil
+}
+
+// Delete key from the cache
+func (c *MemoryCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.values, key)
+	return nil
+}
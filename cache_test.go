@@ -0,0 +1,113 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := NewMemoryCache()
+
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), -time.Minute)
+
+	if v, ok, _ := c.Get("a"); !ok || string(v) != "1" {
+		t.Error("value is not cached", string(v), ok)
+	}
+	if _, ok, _ := c.Get("b"); ok {
+		t.Error("expired value is returned")
+	}
+
+	c.Delete("a")
+	if _, ok, _ := c.Get("a"); ok {
+		t.Error("deleted value is returned")
+	}
+}
+
+func TestCache(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			atomic.AddInt32(&requests, 1)
+		}
+		w.Write([]byte(`{"applicantId": "5cb56e8e0a975a35f333cb83", "reviewStatus": "pending"}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+	WithCache(nil, time.Minute)(s)
+
+	const id = "5cb56e8e0a975a35f333cb83"
+	get := func() {
+		status, err := s.GetApplicantStatus(id)
+		if err != nil || status.ReviewStatus != ReviewStatusPending {
+			t.Fatal("unexpected status", status.ReviewStatus, err)
+		}
+	}
+
+	get()
+	get()
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Error("status is not cached, requests:", n)
+	}
+
+	s.SimulateApproval(id)
+	get()
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Error("cache is not invalidated by mutating request, requests:", n)
+	}
+
+	h := NewWebhookHandler("")
+	h.InvalidateCache(s)
+	h.Dispatch(&WebhookPayload{Type: WebhookApplicantReviewed, ApplicantID: id})
+	get()
+	if n := atomic.LoadInt32(&requests); n != 3 {
+		t.Error("cache is not invalidated by webhook, requests:", n)
+	}
+}
+
+func TestCacheTags(t *testing.T) {
+	tags := `["a"]`
+	var posted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			json.NewDecoder(r.Body).Decode(&posted)
+			w.Write([]byte(`{"ok": 1}`))
+			return
+		}
+		w.Write([]byte(`{"list": {"items": [{"id": "id", "tags": ` + tags + `}], "totalItems": 1}}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+	WithCache(nil, time.Minute)(s)
+
+	if _, err := s.GetApplicant("id"); err != nil {
+		t.Fatal(err)
+	}
+
+	// tag is added by another client
+	tags = `["a", "b"]`
+	if err := s.AddApplicantTags("id", "c"); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(posted, ",") != "a,b,c" {
+		t.Error("tags are changed on cached applicant", posted)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	s := &SumSub{}
+	WithCache(nil, 0)(s)
+	if s.cache != nil {
+		t.Error("cache should be disabled for zero ttl")
+	}
+}
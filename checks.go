@@ -0,0 +1,51 @@
+package sumsub
+
+import (
+	"encoding/json"
+
+	"github.com/imroc/req"
+)
+
+// check types
+const (
+	CheckTypeCompany = "COMPANY"
+	CheckTypeAML     = "AML"
+)
+
+// Check is result of the applicant check performed by sumsub, Data is check
+// type specific part decoded by the corresponding method
+type Check struct {
+	ID        string `json:"id,omitempty"`
+	CheckType string `json:"checkType"`
+	Answer    string `json:"answer"`
+	CreatedAt Time   `json:"createdAt"`
+
+	Data json.RawMessage `json:"-"`
+}
+
+// GetLatestChecks returns latest checks of the applicant of the type
+// GET /resources/checks/latest?type=&applicantId=
+func (s *SumSub) GetLatestChecks(id, checkType string) ([]Check, error) {
+	resp, err := s.do("GET", "resources/checks/latest", req.QueryParam{"type": checkType, "applicantId": id})
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		Checks []json.RawMessage `json:"checks"`
+	}
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	checks := make([]Check, len(list.Checks))
+	for i, data := range list.Checks {
+		if err := json.Unmarshal(data, &checks[i]); err != nil {
+			return nil, err
+		}
+		checks[i].Data = data
+	}
+
+	return checks, err
+}
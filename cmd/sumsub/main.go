@@ -0,0 +1,260 @@
+// Command sumsub is command line client of the sumsub api.
+//
+// Credentials are read from SUMSUB_USER and SUMSUB_PASS environment variables,
+// api address from SUMSUB_ADDR, test environment is used by default:
+//
+//	sumsub create -user-id user-1 -country GBR
+//	sumsub upload -id {applicantId} -type SELFIE -country GBR -file selfie.jpg
+//	sumsub status -id {applicantId} -wait 1m
+//	sumsub token -user-id user-1 -level basic-kyc-level
+//	sumsub simulate -id {applicantId} -answer RED -labels BAD_SELFIE -reject-type RETRY
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sg3des/sumsub"
+)
+
+// command is cli subcommand, it parses args and prints result to stdout
+type command struct {
+	usage string
+	run   func(args []string) (interface{}, error)
+}
+
+var commands = map[string]command{
+	"create":   {"create applicant", create},
+	"upload":   {"upload document to the applicant", upload},
+	"status":   {"print review status of the applicant", status},
+	"token":    {"generate access token for the WebSDK", token},
+	"simulate": {"complete review of the applicant in the test environment", simulate},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	result, err := cmd.run(os.Args[2:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "sumsub:", err)
+		os.Exit(1)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		fmt.Fprintln(os.Stderr, "sumsub:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: sumsub <command> [flags]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, name := range []string{"create", "upload", "status", "token", "simulate"} {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].usage)
+	}
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "credentials are read from SUMSUB_USER and SUMSUB_PASS, address from SUMSUB_ADDR")
+}
+
+// client connects to the address from SUMSUB_ADDR with credentials from env
+func client() (*sumsub.SumSub, error) {
+	addr := os.Getenv("SUMSUB_ADDR")
+	if addr == "" {
+		addr = sumsub.TestAddr
+	}
+
+	return sumsub.NewClientWithCredentials(addr, sumsub.EnvCredentials{})
+}
+
+// split comma separated list, empty string results in nil
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
+
+func create(args []string) (interface{}, error) {
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+	userID := fs.String("user-id", "", "external user id, required")
+	country := fs.String("country", "", "country of the applicant, ISO alpha-3 code")
+	firstName := fs.String("first-name", "", "first name")
+	lastName := fs.String("last-name", "", "last name")
+	email := fs.String("email", "", "email")
+	identity := fs.String("identity", "", "comma separated identity document types, e.g. PASSPORT,ID_CARD")
+	selfie := fs.Bool("selfie", true, "require selfie")
+	fs.Parse(args)
+
+	if *userID == "" {
+		return nil, errors.New("user-id is required")
+	}
+
+	a := sumsub.Applicant{
+		ExternalUserID: *userID,
+		Email:          *email,
+		Info: sumsub.ApplicantInfo{
+			Country:   *country,
+			FirstName: *firstName,
+			LastName:  *lastName,
+		},
+	}
+	if types := split(*identity); len(types) > 0 {
+		a.RequiredIdDocs.DocSets = append(a.RequiredIdDocs.DocSets, sumsub.ApplicantDoc{
+			IDDocSetType: sumsub.IDDocSetType_IDENTITY,
+			Types:        types,
+		})
+	}
+	if *selfie {
+		a.RequiredIdDocs.DocSets = append(a.RequiredIdDocs.DocSets, sumsub.ApplicantDoc{
+			IDDocSetType: sumsub.IDDocSetType_SELFIE,
+			Types:        []string{sumsub.DocSetType_SELFIE},
+		})
+	}
+
+	s, err := client()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.CreateApplicant(&a)
+	return a, err
+}
+
+func upload(args []string) (interface{}, error) {
+	fs := flag.NewFlagSet("upload", flag.ExitOnError)
+	id := fs.String("id", "", "applicant id, required")
+	docType := fs.String("type", "", "document type, e.g. PASSPORT or SELFIE, required")
+	subType := fs.String("side", "", "document side: FRONT_SIDE or BACK_SIDE")
+	country := fs.String("country", "", "country of the document, ISO alpha-3 code, required")
+	file := fs.String("file", "", "path to the document image, required")
+	fs.Parse(args)
+
+	if *id == "" || *docType == "" || *country == "" || *file == "" {
+		return nil, errors.New("id, type, country and file are required")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s, err := client()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := sumsub.DocumentMetaData{
+		IDDocType:    *docType,
+		IDDocSubType: *subType,
+		Country:      *country,
+	}
+
+	result, err := s.UploadDocument(*id, sumsub.Document{Metadata: metadata, Content: f})
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		sumsub.DocumentResult
+		ImageID string `json:"imageId"`
+	}{result, result.ImageID}, nil
+}
+
+func status(args []string) (interface{}, error) {
+	fs := flag.NewFlagSet("status", flag.ExitOnError)
+	id := fs.String("id", "", "applicant id, required")
+	wait := fs.Duration("wait", 0, "wait until review is completed")
+	fs.Parse(args)
+
+	if *id == "" {
+		return nil, errors.New("id is required")
+	}
+
+	s, err := client()
+	if err != nil {
+		return nil, err
+	}
+
+	if *wait <= 0 {
+		return s.GetApplicantStatus(*id)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *wait)
+	defer cancel()
+
+	result, err := s.WaitForReview(ctx, *id, sumsub.PollOptions{})
+	if err == context.DeadlineExceeded {
+		err = nil
+	}
+
+	return result, err
+}
+
+func token(args []string) (interface{}, error) {
+	fs := flag.NewFlagSet("token", flag.ExitOnError)
+	userID := fs.String("user-id", "", "external user id, required")
+	level := fs.String("level", "", "level name, required")
+	ttl := fs.Duration("ttl", 0, "token lifetime, default is set by sumsub")
+	fs.Parse(args)
+
+	if *userID == "" || *level == "" {
+		return nil, errors.New("user-id and level are required")
+	}
+
+	s, err := client()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GenerateAccessToken(*userID, *level, *ttl)
+}
+
+func simulate(args []string) (interface{}, error) {
+	fs := flag.NewFlagSet("simulate", flag.ExitOnError)
+	id := fs.String("id", "", "applicant id, required")
+	answer := fs.String("answer", sumsub.ReviewResultGREEN, "review answer: GREEN or RED")
+	labels := fs.String("labels", "", "comma separated reject labels for RED answer")
+	rejectType := fs.String("reject-type", "", "reject type for RED answer: FINAL or RETRY")
+	comment := fs.String("comment", "", "moderation comment")
+	fs.Parse(args)
+
+	if *id == "" {
+		return nil, errors.New("id is required")
+	}
+
+	s, err := client()
+	if err != nil {
+		return nil, err
+	}
+
+	data := sumsub.ApplicantCompleteRequest{
+		ReviewAnswer:      *answer,
+		ReviewRejectType:  *rejectType,
+		RejectLabels:      split(*labels),
+		ModerationComment: *comment,
+	}
+	if err := s.ApplicantComplete(*id, data); err != nil {
+		return nil, err
+	}
+
+	return s.GetApplicantStatus(*id)
+}
@@ -0,0 +1,297 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/imroc/req"
+)
+
+// applicant types
+const (
+	ApplicantTypeIndividual = "individual"
+	ApplicantTypeCompany    = "company"
+)
+
+// CompanyInfo is data of the company applicant
+type CompanyInfo struct {
+	CompanyName          string `json:"companyName,omitempty"`
+	RegistrationNumber   string `json:"registrationNumber,omitempty"`
+	Country              string `json:"country,omitempty"`
+	LegalAddress         string `json:"legalAddress,omitempty"`
+	PostalAddress        string `json:"postalAddress,omitempty"`
+	IncorporatedOn       Date   `json:"incorporatedOn,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	Email                string `json:"email,omitempty"`
+	Phone                string `json:"phone,omitempty"`
+	TaxID                string `json:"taxId,omitempty"`
+	RegistrationLocation string `json:"registrationLocation,omitempty"`
+	Website              string `json:"website,omitempty"`
+
+	Beneficiaries []Beneficiary `json:"beneficiaries,omitempty"`
+}
+
+// beneficiary types, person may have several types
+const (
+	BeneficiaryTypeUBO            = "ubo"
+	BeneficiaryTypeShareholder    = "shareholder"
+	BeneficiaryTypeDirector       = "director"
+	BeneficiaryTypeRepresentative = "representative"
+)
+
+// Beneficiary is person related to the company, e.g. UBO or director, who
+// is verified as separate applicant
+type Beneficiary struct {
+	ID          string   `json:"id,omitempty"`
+	ApplicantID string   `json:"applicantId"`
+	Types       []string `json:"types,omitempty"`
+
+	// ShareSize is percentage of the company shares owned by beneficiary
+	ShareSize float64 `json:"shareSize,omitempty"`
+}
+
+// HasType reports whether beneficiary has the type
+func (b Beneficiary) HasType(beneficiaryType string) bool {
+	return containsString(b.Types, beneficiaryType)
+}
+
+// IsCompany reports whether applicant is company
+func (a Applicant) IsCompany() bool {
+	return a.Type == ApplicantTypeCompany
+}
+
+// CreateCompanyApplicant creates applicant of company type, a.Info.CompanyInfo
+// should be set
+// POST /resources/applicants
+func (s *SumSub) CreateCompanyApplicant(a *Applicant) error {
+	a.Type = ApplicantTypeCompany
+	return s.CreateApplicant(a)
+}
+
+// UpdateCompanyInfo of the company applicant, only specified fields are
+// changed
+// PATCH /resources/applicants/{applicantId}/info/companyInfo
+func (s *SumSub) UpdateCompanyInfo(id string, info CompanyInfo) (updated CompanyInfo, err error) {
+	resp, err := s.do("PATCH", "resources/applicants/"+id+"/info/companyInfo", req.BodyJSON(info))
+	if err := handleResponse(resp, err); err != nil {
+		return updated, err
+	}
+
+	err = s.decode(resp, &updated)
+	return
+}
+
+// AddBeneficiary links individual applicant to the company applicant id,
+// beneficiary is validated if client is created with WithValidation option
+// POST /resources/applicants/{applicantId}/info/companyInfo/beneficiaries
+func (s *SumSub) AddBeneficiary(id string, b Beneficiary) (added Beneficiary, err error) {
+	if s.validate {
+		if err := b.Validate(); err != nil {
+			return added, err
+		}
+	}
+
+	resp, err := s.do("POST", "resources/applicants/"+id+"/info/companyInfo/beneficiaries", req.BodyJSON(b))
+	if err := handleResponse(resp, err); err != nil {
+		return added, err
+	}
+
+	err = s.decode(resp, &added)
+	return
+}
+
+// UpdateBeneficiary types and share size, b.ID should be set
+// PATCH /resources/applicants/{applicantId}/info/companyInfo/beneficiaries/{beneficiaryId}
+func (s *SumSub) UpdateBeneficiary(id string, b Beneficiary) (updated Beneficiary, err error) {
+	if b.ID == "" {
+		return updated, errors.New("beneficiary id is empty")
+	}
+
+	resp, err := s.do("PATCH", "resources/applicants/"+id+"/info/companyInfo/beneficiaries/"+b.ID, req.BodyJSON(b))
+	if err := handleResponse(resp, err); err != nil {
+		return updated, err
+	}
+
+	err = s.decode(resp, &updated)
+	return
+}
+
+// RemoveBeneficiary from the company applicant, applicant of the beneficiary
+// is not deleted
+// DELETE /resources/applicants/{applicantId}/info/companyInfo/beneficiaries/{beneficiaryId}
+func (s *SumSub) RemoveBeneficiary(id, beneficiaryID string) error {
+	resp, err := s.do("DELETE", "resources/applicants/"+id+"/info/companyInfo/beneficiaries/"+beneficiaryID)
+	return handleResponse(resp, err)
+}
+
+// CompanyRegistryData is company data collected by sumsub from the corporate
+// registries
+type CompanyRegistryData struct {
+	CompanyName        string `json:"companyName"`
+	RegistrationNumber string `json:"registrationNumber,omitempty"`
+	Country            string `json:"country,omitempty"`
+	LegalAddress       string `json:"legalAddress,omitempty"`
+	IncorporatedOn     Date   `json:"incorporatedOn,omitempty"`
+	Type               string `json:"type,omitempty"`
+
+	// Status is registry status of the company, e.g. active or dissolved
+	Status string `json:"status,omitempty"`
+
+	Officers         []CompanyOfficer  `json:"officers,omitempty"`
+	RegistryExcerpts []RegistryExcerpt `json:"sources,omitempty"`
+}
+
+// CompanyOfficer is director or other officer listed in the registry
+type CompanyOfficer struct {
+	Name     string `json:"name"`
+	Position string `json:"position,omitempty"`
+}
+
+// RegistryExcerpt is registry the data is obtained from
+type RegistryExcerpt struct {
+	Name      string `json:"name"`
+	URL       string `json:"url,omitempty"`
+	FetchedAt Time   `json:"fetchedAt,omitempty"`
+}
+
+// CompanyCheck is result of the company registry check
+type CompanyCheck struct {
+	Check
+	CompanyCheckInfo CompanyRegistryData `json:"companyCheckInfo"`
+}
+
+// GetCompanyRegistryData returns latest company registry checks of the
+// company applicant
+// GET /resources/checks/latest?type=COMPANY&applicantId=
+func (s *SumSub) GetCompanyRegistryData(id string) ([]CompanyCheck, error) {
+	checks, err := s.GetLatestChecks(id, CheckTypeCompany)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]CompanyCheck, len(checks))
+	for i, check := range checks {
+		if err := json.Unmarshal(check.Data, &result[i]); err != nil {
+			return nil, err
+		}
+		result[i].Check = check
+	}
+
+	return result, nil
+}
+
+// ApplicantMembership is relation of the individual applicant to the company
+type ApplicantMembership struct {
+	ApplicantID string `json:"applicantId"`
+}
+
+// LinkApplicantToCompany attaches existing individual applicant to the company
+// applicant as beneficiary, verification of the individual is reused
+func (s *SumSub) LinkApplicantToCompany(companyID, applicantID string, types []string, shareSize float64) (Beneficiary, error) {
+	a, err := s.GetApplicant(applicantID)
+	if err != nil {
+		return Beneficiary{}, err
+	}
+	if a.IsCompany() {
+		return Beneficiary{}, errors.New("applicant " + applicantID + " is company")
+	}
+
+	return s.AddBeneficiary(companyID, Beneficiary{
+		ApplicantID: applicantID,
+		Types:       types,
+		ShareSize:   shareSize,
+	})
+}
+
+// IsMemberOf reports whether applicant is beneficiary of the company
+func (a Applicant) IsMemberOf(companyID string) bool {
+	for _, m := range a.MemberOf {
+		if m.ApplicantID == companyID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BeneficiaryInvitation is verification link for the beneficiary
+type BeneficiaryInvitation struct {
+	Beneficiary    Beneficiary
+	ExternalUserID string
+	Link           WebSDKLink
+}
+
+// InviteBeneficiaries generates WebSDK links of the level for beneficiaries
+// of the company who are not verified yet, opts.UserID is ignored
+func (s *SumSub) InviteBeneficiaries(companyID, levelName string, opts WebSDKLinkOptions) ([]BeneficiaryInvitation, error) {
+	outstanding, err := s.outstandingBeneficiaries(companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]BeneficiaryInvitation, 0, len(outstanding))
+	for _, o := range outstanding {
+		opts.UserID = o.applicant.ExternalUserID
+
+		link, err := s.GenerateWebSDKLink(levelName, opts)
+		if err != nil {
+			return invitations, err
+		}
+
+		invitations = append(invitations, BeneficiaryInvitation{
+			Beneficiary:    o.beneficiary,
+			ExternalUserID: o.applicant.ExternalUserID,
+			Link:           link,
+		})
+	}
+
+	return invitations, nil
+}
+
+// OutstandingBeneficiaries returns beneficiaries of the company who have not
+// passed verification yet
+func (s *SumSub) OutstandingBeneficiaries(companyID string) ([]Beneficiary, error) {
+	outstanding, err := s.outstandingBeneficiaries(companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	beneficiaries := make([]Beneficiary, len(outstanding))
+	for i, o := range outstanding {
+		beneficiaries[i] = o.beneficiary
+	}
+
+	return beneficiaries, nil
+}
+
+type outstandingBeneficiary struct {
+	beneficiary Beneficiary
+	applicant   Applicant
+}
+
+func (s *SumSub) outstandingBeneficiaries(companyID string) ([]outstandingBeneficiary, error) {
+	company, err := s.GetApplicant(companyID)
+	if err != nil {
+		return nil, err
+	}
+	if company.Info.CompanyInfo == nil {
+		return nil, errors.New("applicant " + companyID + " is not company")
+	}
+
+	var outstanding []outstandingBeneficiary
+	for _, b := range company.Info.CompanyInfo.Beneficiaries {
+		a, err := s.GetApplicant(b.ApplicantID)
+		if err != nil {
+			return nil, err
+		}
+
+		status := ApplicantStatus{ReviewStatus: a.Review.ReviewStatus, ReviewResult: a.Review.ReviewResult}
+		if _, pass := status.IsPass(); status.IsCompleted() && pass {
+			continue
+		}
+
+		outstanding = append(outstanding, outstandingBeneficiary{beneficiary: b, applicant: a})
+	}
+
+	return outstanding, nil
+}
@@ -0,0 +1,51 @@
+package sumsub
+
+import (
+	"github.com/imroc/req"
+)
+
+// Agreement is record of the consent or terms acceptance by the applicant
+type Agreement struct {
+	ID        string   `json:"id,omitempty"`
+	Text      string   `json:"text,omitempty"`
+	Targets   []string `json:"targets,omitempty"`
+	Source    string   `json:"source,omitempty"`
+	IP        string   `json:"ip,omitempty"`
+	UserAgent string   `json:"userAgent,omitempty"`
+
+	// AcceptedAt is time of the acceptance, time of the request is used by
+	// sumsub if it is nil
+	AcceptedAt *Time `json:"acceptedAt,omitempty"`
+	CreatedAt  *Time `json:"createdAt,omitempty"`
+}
+
+// AddApplicantAgreement records acceptance of the terms by the applicant
+// POST /resources/applicants/{applicantId}/agreements
+func (s *SumSub) AddApplicantAgreement(id string, agreement Agreement) (a Agreement, err error) {
+	resp, err := s.do("POST", "resources/applicants/"+id+"/agreements", req.BodyJSON(agreement))
+	if err := handleResponse(resp, err); err != nil {
+		return a, err
+	}
+
+	err = s.decode(resp, &a)
+	return
+}
+
+// GetApplicantAgreements returns all consents accepted by the applicant
+// GET /resources/applicants/{applicantId}/agreements
+func (s *SumSub) GetApplicantAgreements(id string) ([]Agreement, error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/agreements")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var agreements struct {
+		Items []Agreement `json:"items"`
+	}
+	err = s.decode(resp, &agreements)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return agreements.Items, err
+}
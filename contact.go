@@ -0,0 +1,46 @@
+package sumsub
+
+import (
+	"errors"
+
+	"github.com/imroc/req"
+)
+
+// contacts of the applicant to confirm
+const (
+	ContactEmail = "email"
+	ContactPhone = "phone"
+)
+
+// SendConfirmationCode sends code to the applicant email or phone declared in
+// the applicant info
+// POST /resources/applicants/{applicantId}/{email|phone}Confirmation/send
+func (s *SumSub) SendConfirmationCode(id, contact string) error {
+	if err := checkContact(contact); err != nil {
+		return err
+	}
+
+	resp, err := s.do("POST", "resources/applicants/"+id+"/"+contact+"Confirmation/send")
+	return handleResponse(resp, err)
+}
+
+// ConfirmContact verifies code entered by the applicant
+// POST /resources/applicants/{applicantId}/{email|phone}Confirmation/confirm
+func (s *SumSub) ConfirmContact(id, contact, code string) error {
+	if err := checkContact(contact); err != nil {
+		return err
+	}
+
+	body := map[string]string{"code": code}
+
+	resp, err := s.do("POST", "resources/applicants/"+id+"/"+contact+"Confirmation/confirm", req.BodyJSON(body))
+	return handleResponse(resp, err)
+}
+
+func checkContact(contact string) error {
+	if contact != ContactEmail && contact != ContactPhone {
+		return errors.New("unknown contact type " + contact)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package sumsub
+
+import (
+	"errors"
+	"os"
+)
+
+// CredentialsProvider supplies user and password for authentication, it is
+// consulted every time the token is obtained, so rotated secrets are picked
+// up without recreating the client
+type CredentialsProvider interface {
+	Credentials() (user, pass string, err error)
+}
+
+// StaticCredentials is fixed user and password
+type StaticCredentials struct {
+	User string
+	Pass string
+}
+
+func (c StaticCredentials) Credentials() (string, string, error) {
+	return c.User, c.Pass, nil
+}
+
+// EnvCredentials reads user and password from environment variables,
+// SUMSUB_USER and SUMSUB_PASS are used if names are not specified
+type EnvCredentials struct {
+	UserVar string
+	PassVar string
+}
+
+func (c EnvCredentials) Credentials() (string, string, error) {
+	userVar, passVar := c.UserVar, c.PassVar
+	if userVar == "" {
+		userVar = "SUMSUB_USER"
+	}
+	if passVar == "" {
+		passVar = "SUMSUB_PASS"
+	}
+
+	user, pass := os.Getenv(userVar), os.Getenv(passVar)
+	if user == "" || pass == "" {
+		return "", "", errors.New("credentials not found in " + userVar + ", " + passVar)
+	}
+
+	return user, pass, nil
+}
+
+// CredentialsFunc is adapter to use ordinary function as provider, e.g. to
+// read secrets with own clients of the secret storages. Providers for
+// HashiCorp Vault and AWS Secrets Manager are in sumsubsecrets package:
+//
+//	sumsub.CredentialsFunc(func() (string, string, error) {
+//		secret, err := vault.Logical().Read("secret/sumsub")
+//		...
+//		return secret.Data["user"].(string), secret.Data["pass"].(string), nil
+//	})
+type CredentialsFunc func() (user, pass string, err error)
+
+func (f CredentialsFunc) Credentials() (string, string, error) {
+	return f()
+}
@@ -0,0 +1,31 @@
+package sumsub
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEnvCredentials(t *testing.T) {
+	os.Setenv("TEST_SUMSUB_USER", "user")
+	os.Setenv("TEST_SUMSUB_PASS", "pass")
+	defer os.Unsetenv("TEST_SUMSUB_USER")
+	defer os.Unsetenv("TEST_SUMSUB_PASS")
+
+	user, pass, err := EnvCredentials{UserVar: "TEST_SUMSUB_USER", PassVar: "TEST_SUMSUB_PASS"}.Credentials()
+	if err != nil {
+		t.Error(err)
+	}
+	if user != "user" || pass != "pass" {
+		t.Error("wrong credentials", user, pass)
+	}
+
+	if _, _, err := (EnvCredentials{UserVar: "TEST_SUMSUB_EMPTY"}).Credentials(); err == nil {
+		t.Error("expected error for empty variables")
+	}
+}
+
+func TestNilCredentials(t *testing.T) {
+	if _, err := NewClientWithCredentials(TestAddr, nil); err == nil {
+		t.Error("expected error for nil provider")
+	}
+}
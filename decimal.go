@@ -0,0 +1,156 @@
+package sumsub
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var decimalRe = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// numberRe is decimal number which may be written with exponent, e.g. 1e-2
+var numberRe = regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][-+]?[0-9]+)?$`)
+
+// Decimal is exact decimal number used for monetary amounts. It keeps the
+// value exactly as received from the api or specified by the user, so amounts
+// never pass through float64 and scale (e.g. "10.50") is preserved.
+// In json it is encoded as number, both numbers and strings are accepted on
+// decode.
+type Decimal string
+
+// ParseDecimal validates decimal number written as `-123.45`
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalRe.MatchString(s) {
+		return "", errors.New("invalid decimal: " + s)
+	}
+
+	return Decimal(s), nil
+}
+
+// MustDecimal same as ParseDecimal, but panic on invalid value
+func MustDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// IsZero returns true for empty or zero value
+func (d Decimal) IsZero() bool {
+	return strings.Trim(string(d), "-0.") == ""
+}
+
+// Scale is count of digits after decimal point
+func (d Decimal) Scale() int {
+	if i := strings.IndexByte(string(d), '.'); i >= 0 {
+		return len(d) - i - 1
+	}
+
+	return 0
+}
+
+// Rat returns exact value as rational number
+func (d Decimal) Rat() (*big.Rat, bool) {
+	if d == "" {
+		return new(big.Rat), true
+	}
+
+	return new(big.Rat).SetString(string(d))
+}
+
+// Float64 returns approximate value, it should be used only for display
+func (d Decimal) Float64() (float64, error) {
+	if d == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(string(d), 64)
+}
+
+// Cmp compares d and x and returns -1, 0 or +1
+func (d Decimal) Cmp(x Decimal) int {
+	a, _ := d.Rat()
+	b, _ := x.Rat()
+	if a == nil || b == nil {
+		return strings.Compare(string(d), string(x))
+	}
+
+	return a.Cmp(b)
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d == "" {
+		return []byte("null"), nil
+	}
+
+	if !decimalRe.MatchString(string(d)) {
+		return nil, errors.New("invalid decimal: " + string(d))
+	}
+
+	return []byte(d), nil
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = ""
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	// numbers like 1e-2 are valid json, but should be normalized, other
+	// forms accepted by big.Rat, e.g. fractions 1/3, are rejected because they
+	// can't be written as decimal exactly
+	if !decimalRe.MatchString(s) {
+		if !numberRe.MatchString(s) {
+			return errors.New("invalid decimal: " + s)
+		}
+
+		r, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return errors.New("invalid decimal: " + s)
+		}
+
+		s = r.FloatString(fractionDigits(s))
+	}
+
+	*d = Decimal(s)
+	return nil
+}
+
+// fractionDigits estimates scale of the number written in exponent form
+func fractionDigits(s string) int {
+	s = strings.ToLower(s)
+
+	mantissa, exp := s, 0
+	if i := strings.IndexByte(s, 'e'); i >= 0 {
+		mantissa = s[:i]
+		exp, _ = strconv.Atoi(s[i+1:])
+	}
+
+	digits := 0
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		digits = len(mantissa) - i - 1
+	}
+
+	if digits -= exp; digits < 0 {
+		return 0
+	}
+
+	return digits
+}
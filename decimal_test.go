@@ -0,0 +1,53 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	var v struct {
+		Amount Decimal `json:"amount"`
+		Fee    Decimal `json:"fee"`
+		Rate   Decimal `json:"rate"`
+		Empty  Decimal `json:"empty,omitempty"`
+	}
+
+	data := []byte(`{"amount": 12345678901234567890.10, "fee": "0.50", "rate": 1.5e-3}`)
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if v.Amount != "12345678901234567890.10" || v.Amount.Scale() != 2 {
+		t.Error("amount precision is lost", v.Amount)
+	}
+	if v.Fee != "0.50" {
+		t.Error("wrong fee", v.Fee)
+	}
+	if v.Rate != "0.0015" {
+		t.Error("wrong rate", v.Rate)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"amount":12345678901234567890.10,"fee":0.50,"rate":0.0015}` {
+		t.Error("wrong encoding", string(out))
+	}
+
+	for _, value := range []string{`"1/3"`, `"0x10"`, `"1.5.0"`, `"Inf"`} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(value), &d); err == nil {
+			t.Errorf("expected error for %s, got %s", value, d)
+		}
+	}
+
+	if _, err := ParseDecimal("1,5"); err == nil {
+		t.Error("expected error for invalid decimal")
+	}
+
+	if MustDecimal("1.50").Cmp(MustDecimal("1.5")) != 0 {
+		t.Error("1.50 should be equal to 1.5")
+	}
+}
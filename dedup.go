@@ -0,0 +1,110 @@
+package sumsub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"sync"
+	"time"
+)
+
+// DuplicatePolicy defines what to do when the same document content is
+// uploaded to the applicant again
+type DuplicatePolicy int
+
+const (
+	// DuplicatesAllow uploads documents without checks
+	DuplicatesAllow DuplicatePolicy = iota
+
+	// DuplicatesSkip does not upload duplicate, result of the previous upload
+	// is returned with Duplicate flag
+	DuplicatesSkip
+
+	// DuplicatesWarn uploads duplicate, but logs warning and sets Duplicate flag
+	DuplicatesWarn
+)
+
+// DefaultDuplicateTTL is how long uploaded documents are remembered by
+// WithDuplicateCheck if ttl is not specified
+const DefaultDuplicateTTL = 24 * time.Hour
+
+// WithDuplicateCheck computes SHA-256 of the uploaded documents and applies
+// policy to the content that was already uploaded to the applicant by this
+// client during ttl, DefaultDuplicateTTL is used if ttl is zero. Seekable
+// content (files, bytes.Reader) is hashed before upload. Other content is
+// hashed while it is streamed, so it is never skipped, but Duplicate flag is
+// set and warning is logged after upload.
+func WithDuplicateCheck(policy DuplicatePolicy, ttl time.Duration) Option {
+	return func(s *SumSub) {
+		if ttl <= 0 {
+			ttl = DefaultDuplicateTTL
+		}
+
+		s.duplicates = policy
+		s.uploads = &uploadsRegistry{ttl: ttl, results: make(map[string]uploadedDocument)}
+	}
+}
+
+// uploadsRegistry remembers uploaded documents by applicant id and checksum
+// for ttl
+type uploadsRegistry struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	results map[string]uploadedDocument
+}
+
+type uploadedDocument struct {
+	result  DocumentResult
+	expires time.Time
+}
+
+func (r *uploadsRegistry) get(id, checksum string) (DocumentResult, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	doc, ok := r.results[id+":"+checksum]
+	if !ok || !time.Now().Before(doc.expires) {
+		return DocumentResult{}, false
+	}
+
+	return doc.result, true
+}
+
+// add uploaded document, expired documents are removed
+func (r *uploadsRegistry) add(id string, result DocumentResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for k, doc := range r.results {
+		if !now.Before(doc.expires) {
+			delete(r.results, k)
+		}
+	}
+
+	r.results[id+":"+result.Checksum] = uploadedDocument{result: result, expires: now.Add(r.ttl)}
+}
+
+// checksum computes SHA-256 of the seekable content and rewinds it, ok is
+// false if content is not seekable
+func (doc *Document) checksum() (checksum string, ok bool, err error) {
+	rs, ok := doc.Content.(io.ReadSeeker)
+	if !ok {
+		return "", false, nil
+	}
+
+	start, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", false, err
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, rs); err != nil {
+		return "", false, err
+	}
+	if _, err := rs.Seek(start, io.SeekStart); err != nil {
+		return "", false, err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), true, nil
+}
@@ -0,0 +1,41 @@
+package sumsub
+
+import (
+	"errors"
+
+	"github.com/imroc/req"
+)
+
+// GetSupportedDocTypes returns ID document types accepted by sumsub by ISO
+// 3166-1 alpha-3 country code
+// GET /resources/idDocTypes
+func (s *SumSub) GetSupportedDocTypes() (types map[string][]string, err error) {
+	resp, err := s.do("GET", "resources/idDocTypes")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	err = s.decode(resp, &types)
+	return
+}
+
+// GetCountryDocTypes returns ID document types accepted for the country
+// GET /resources/idDocTypes?country=
+func (s *SumSub) GetCountryDocTypes(country string) ([]string, error) {
+	if !IsCountry(country) {
+		return nil, errors.New("invalid country " + country)
+	}
+
+	resp, err := s.do("GET", "resources/idDocTypes", req.QueryParam{"country": country})
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var types map[string][]string
+	err = s.decode(resp, &types)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return types[country], err
+}
@@ -0,0 +1,234 @@
+package sumsub
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/imroc/req"
+)
+
+// DocumentResource is uploaded document image of the applicant
+type DocumentResource struct {
+	ID          string `json:"id"`
+	PreviewID   string `json:"previewId,omitempty"`
+	AddedDate   Time   `json:"addedDate"`
+	AttemptID   string `json:"attemptId,omitempty"`
+	Source      string `json:"source,omitempty"`
+	Deactivated bool   `json:"deactivated"`
+
+	FileMetadata DocumentFileMetadata `json:"fileMetadata"`
+	IDDocDef     DocumentDefinition   `json:"idDocDef"`
+	ReviewResult ReviewResult         `json:"reviewResult"`
+}
+
+type DocumentFileMetadata struct {
+	FileName   string `json:"fileName"`
+	FileType   string `json:"fileType"`
+	FileSize   int64  `json:"fileSize"`
+	Resolution struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"resolution"`
+}
+
+type DocumentDefinition struct {
+	Country      string `json:"country"`
+	IDDocType    string `json:"idDocType"`
+	IDDocSubType string `json:"idDocSubType,omitempty"`
+}
+
+// GetApplicantDocuments returns all uploaded images of the applicant including
+// deactivated ones
+// GET /resources/applicants/{applicantId}/metadata/resources
+func (s *SumSub) GetApplicantDocuments(id string) ([]DocumentResource, error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/metadata/resources")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var docs struct {
+		Items []DocumentResource `json:"items"`
+	}
+	err = s.decode(resp, &docs)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return docs.Items, err
+}
+
+// GetDocumentImage downloads document image and returns its content and
+// content type
+// GET /resources/inspections/{inspectionId}/resources/{imageId}
+func (s *SumSub) GetDocumentImage(inspectionID, imageID string) (data []byte, contentType string, err error) {
+	var buf bytes.Buffer
+	contentType, err = s.WriteDocumentImage(&buf, inspectionID, imageID)
+	return buf.Bytes(), contentType, err
+}
+
+// WriteDocumentImage streams document image into w without buffering it in
+// memory and returns its content type
+// GET /resources/inspections/{inspectionId}/resources/{imageId}
+func (s *SumSub) WriteDocumentImage(w io.Writer, inspectionID, imageID string) (contentType string, err error) {
+	resp, err := s.do("GET", "resources/inspections/"+inspectionID+"/resources/"+imageID)
+	if err := handleResponse(resp, err); err != nil {
+		return "", err
+	}
+
+	r := resp.Response()
+	defer r.Body.Close()
+
+	if _, err := io.Copy(w, r.Body); err != nil {
+		return "", err
+	}
+
+	return r.Header.Get("Content-Type"), nil
+}
+
+// DocumentImage is downloaded image of the applicant document
+type DocumentImage struct {
+	FileName    string
+	ContentType string
+	Document    DocumentResource
+	Content     []byte
+}
+
+// DownloadApplicantImages downloads all images of the applicant, including
+// deactivated ones, with at most concurrency parallel requests. Function fn is
+// called for each image sequentially, download stops on the first error.
+func (s *SumSub) DownloadApplicantImages(id string, concurrency int, fn func(DocumentImage) error) error {
+	a, err := s.GetApplicant(id)
+	if err != nil {
+		return err
+	}
+
+	docs, err := s.GetApplicantDocuments(id)
+	if err != nil {
+		return err
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	sem := make(chan struct{}, concurrency)
+	for _, doc := range docs {
+		sem <- struct{}{}
+
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(doc DocumentResource) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			data, contentType, err := s.GetDocumentImage(a.InspectionID, doc.ID)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if firstErr != nil {
+				return
+			}
+			if err != nil {
+				firstErr = fmt.Errorf("image %s: %v", doc.ID, err)
+				return
+			}
+
+			filename := doc.FileMetadata.FileName
+			if filename == "" {
+				filename = doc.ID
+			}
+
+			firstErr = fn(DocumentImage{
+				FileName:    filename,
+				ContentType: contentType,
+				Document:    doc,
+				Content:     data,
+			})
+		}(doc)
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+// DeactivateDocumentImage marks uploaded image as deleted, it is not used in
+// the review anymore
+// DELETE /resources/inspections/{inspectionId}/resources/{imageId}
+func (s *SumSub) DeactivateDocumentImage(inspectionID, imageID string) error {
+	resp, err := s.do("DELETE", "resources/inspections/"+inspectionID+"/resources/"+imageID)
+	return handleResponse(resp, err)
+}
+
+// RotateDocumentImage rotates uploaded image clockwise, angle should be
+// multiple of 90 degrees
+// POST /resources/inspections/{inspectionId}/resources/{imageId}/rotate?angle=
+func (s *SumSub) RotateDocumentImage(inspectionID, imageID string, angle int) error {
+	if angle%90 != 0 {
+		return fmt.Errorf("invalid rotation angle %d, should be multiple of 90", angle)
+	}
+
+	angle = (angle%360 + 360) % 360
+	if angle == 0 {
+		return nil
+	}
+
+	resp, err := s.do("POST", "resources/inspections/"+inspectionID+"/resources/"+imageID+"/rotate", req.QueryParam{"angle": angle})
+	return handleResponse(resp, err)
+}
+
+// types of the applicant summary report
+const (
+	ReportTypeApplicant       = "applicantReport"
+	ReportTypeApplicantAction = "applicantActionReport"
+)
+
+// GetApplicantReport downloads official verification report of the applicant
+// in PDF, lang is language of the report, e.g. "en"
+// GET /resources/applicants/{applicantId}/summary/report?report=&lang=
+func (s *SumSub) GetApplicantReport(id, reportType, lang string) ([]byte, error) {
+	var buf bytes.Buffer
+	err := s.WriteApplicantReport(&buf, id, reportType, lang)
+	return buf.Bytes(), err
+}
+
+// WriteApplicantReport streams verification report of the applicant into w
+// GET /resources/applicants/{applicantId}/summary/report?report=&lang=
+func (s *SumSub) WriteApplicantReport(w io.Writer, id, reportType, lang string) error {
+	if reportType == "" {
+		reportType = ReportTypeApplicant
+	}
+
+	query := req.QueryParam{"report": reportType}
+	if lang != "" {
+		query["lang"] = lang
+	}
+
+	resp, err := s.do("GET", "resources/applicants/"+id+"/summary/report", query)
+	if err := handleResponse(resp, err); err != nil {
+		return err
+	}
+
+	r := resp.Response()
+	defer r.Body.Close()
+
+	_, err = io.Copy(w, r.Body)
+	return err
+}
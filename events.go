@@ -0,0 +1,185 @@
+package sumsub
+
+import (
+	"encoding/json"
+
+	"github.com/imroc/req"
+)
+
+// types of the applicant events
+const (
+	EventApplicantCreated    = "applicantCreated"
+	EventDocumentAdded       = "idDocAdded"
+	EventDocumentDeactivated = "idDocDeactivated"
+	EventStatusChanged       = "statusChanged"
+	EventLevelChanged        = "levelChanged"
+	EventInfoChanged         = "infoChanged"
+	EventReviewed            = "applicantReviewed"
+	EventNoteAdded           = "noteAdded"
+	EventTagsChanged         = "tagsChanged"
+)
+
+// ApplicantEvent is record of the applicant activity, e.g. document added or
+// status changed
+type ApplicantEvent struct {
+	ID          string         `json:"id"`
+	ApplicantID string         `json:"applicantId"`
+	Type        string         `json:"type"`
+	CreatedAt   Time           `json:"createdAt"`
+	Initiator   EventInitiator `json:"initiator"`
+
+	// Data is event specific payload, Details decodes it into the struct of
+	// the event type
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// EventInitiator is who performed the action: applicant, client by api,
+// dashboard user or sumsub system
+type EventInitiator struct {
+	Type  string `json:"type"`
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// DecodeData unmarshals event specific payload into v
+func (e ApplicantEvent) DecodeData(v interface{}) error {
+	if len(e.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(e.Data, v)
+}
+
+// ApplicantCreatedEvent is payload of the applicantCreated event
+type ApplicantCreatedEvent struct {
+	ExternalUserID string `json:"externalUserId,omitempty"`
+	LevelName      string `json:"levelName,omitempty"`
+}
+
+// DocumentEvent is payload of the idDocAdded and idDocDeactivated events
+type DocumentEvent struct {
+	IDDocType    string `json:"idDocType"`
+	IDDocSubType string `json:"idDocSubType,omitempty"`
+	Country      string `json:"country,omitempty"`
+	ImageID      int    `json:"imageId,omitempty"`
+}
+
+// StatusChangedEvent is payload of the statusChanged event
+type StatusChangedEvent struct {
+	PrevReviewStatus string `json:"prevReviewStatus,omitempty"`
+	ReviewStatus     string `json:"reviewStatus"`
+}
+
+// LevelChangedEvent is payload of the levelChanged event
+type LevelChangedEvent struct {
+	PrevLevelName string `json:"prevLevelName,omitempty"`
+	LevelName     string `json:"levelName"`
+}
+
+// InfoChangedEvent is payload of the infoChanged event, Fields are names of
+// the changed applicant info fields
+type InfoChangedEvent struct {
+	Fields []string `json:"fields,omitempty"`
+}
+
+// ReviewedEvent is payload of the applicantReviewed event
+type ReviewedEvent struct {
+	ReviewStatus string       `json:"reviewStatus,omitempty"`
+	ReviewResult ReviewResult `json:"reviewResult"`
+}
+
+// NoteAddedEvent is payload of the noteAdded event
+type NoteAddedEvent struct {
+	NoteID string `json:"noteId,omitempty"`
+	Note   string `json:"note"`
+}
+
+// TagsChangedEvent is payload of the tagsChanged event
+type TagsChangedEvent struct {
+	PrevTags []string `json:"prevTags,omitempty"`
+	Tags     []string `json:"tags"`
+}
+
+// eventTypes creates empty payload by event type
+var eventTypes = map[string]func() interface{}{
+	EventApplicantCreated:    func() interface{} { return new(ApplicantCreatedEvent) },
+	EventDocumentAdded:       func() interface{} { return new(DocumentEvent) },
+	EventDocumentDeactivated: func() interface{} { return new(DocumentEvent) },
+	EventStatusChanged:       func() interface{} { return new(StatusChangedEvent) },
+	EventLevelChanged:        func() interface{} { return new(LevelChangedEvent) },
+	EventInfoChanged:         func() interface{} { return new(InfoChangedEvent) },
+	EventReviewed:            func() interface{} { return new(ReviewedEvent) },
+	EventNoteAdded:           func() interface{} { return new(NoteAddedEvent) },
+	EventTagsChanged:         func() interface{} { return new(TagsChangedEvent) },
+}
+
+// Details decodes event payload into the struct of its type, use type switch
+// to get specific payload, e.g. *StatusChangedEvent. Payload of unknown type
+// is returned as json.RawMessage
+func (e ApplicantEvent) Details() (interface{}, error) {
+	newDetails, ok := eventTypes[e.Type]
+	if !ok {
+		return e.Data, nil
+	}
+
+	details := newDetails()
+	if err := e.DecodeData(details); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// StatusChanged returns payload of the statusChanged event, ok is false for
+// events of other types
+func (e ApplicantEvent) StatusChanged() (event StatusChangedEvent, ok bool, err error) {
+	if e.Type != EventStatusChanged {
+		return event, false, nil
+	}
+
+	return event, true, e.DecodeData(&event)
+}
+
+// Document returns payload of the idDocAdded and idDocDeactivated events, ok
+// is false for events of other types
+func (e ApplicantEvent) Document() (event DocumentEvent, ok bool, err error) {
+	if e.Type != EventDocumentAdded && e.Type != EventDocumentDeactivated {
+		return event, false, nil
+	}
+
+	return event, true, e.DecodeData(&event)
+}
+
+// LevelChanged returns payload of the levelChanged event, ok is false for
+// events of other types
+func (e ApplicantEvent) LevelChanged() (event LevelChangedEvent, ok bool, err error) {
+	if e.Type != EventLevelChanged {
+		return event, false, nil
+	}
+
+	return event, true, e.DecodeData(&event)
+}
+
+// GetApplicantEvents returns activity of the applicant ordered from the
+// newest events
+// GET /resources/applicants/{applicantId}/events
+func (s *SumSub) GetApplicantEvents(id string, offset, limit int) (events []ApplicantEvent, total int, err error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/events", req.QueryParam{"offset": offset, "limit": limit})
+	if err := handleResponse(resp, err); err != nil {
+		return nil, 0, err
+	}
+
+	var list struct {
+		List struct {
+			Items      []ApplicantEvent `json:"items"`
+			TotalItems int              `json:"totalItems"`
+		} `json:"list"`
+	}
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
+		return nil, 0, err
+	}
+
+	return list.List.Items, list.List.TotalItems, err
+}
@@ -0,0 +1,43 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestApplicantEventDetails(t *testing.T) {
+	var events []ApplicantEvent
+	err := json.Unmarshal([]byte(`[
+		{"id": "e1", "type": "statusChanged", "createdAt": "2021-06-01 12:00:00", "initiator": {"type": "system"}, "data": {"prevReviewStatus": "pending", "reviewStatus": "completed"}},
+		{"id": "e2", "type": "idDocAdded", "initiator": {"type": "applicant"}, "data": {"idDocType": "PASSPORT", "country": "GBR", "imageId": 123}},
+		{"id": "e3", "type": "levelChanged", "initiator": {"type": "user", "email": "officer@example.com"}, "data": {"prevLevelName": "basic", "levelName": "advanced"}},
+		{"id": "e4", "type": "applicantReviewed", "data": {"reviewResult": {"reviewAnswer": "RED", "rejectLabels": ["FORGERY"]}}},
+		{"id": "e5", "type": "somethingNew", "data": {"a": 1}}
+	]`), &events)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status, ok, err := events[0].StatusChanged(); !ok || err != nil || status.PrevReviewStatus != ReviewStatusPending || status.ReviewStatus != ReviewStatusCompleted {
+		t.Error("wrong status changed event", status, ok, err)
+	}
+	if _, ok, _ := events[1].StatusChanged(); ok {
+		t.Error("document event is returned as status changed")
+	}
+	if doc, ok, err := events[1].Document(); !ok || err != nil || doc.IDDocType != "PASSPORT" || doc.ImageID != 123 {
+		t.Error("wrong document event", doc, ok, err)
+	}
+	if level, ok, err := events[2].LevelChanged(); !ok || err != nil || level.PrevLevelName != "basic" || level.LevelName != "advanced" {
+		t.Error("wrong level changed event", level, ok, err)
+	}
+
+	details, err := events[3].Details()
+	if reviewed, ok := details.(*ReviewedEvent); !ok || err != nil || reviewed.ReviewResult.ReviewAnswer != ReviewResultRED {
+		t.Errorf("wrong reviewed event %#v %v", details, err)
+	}
+
+	details, err = events[4].Details()
+	if raw, ok := details.(json.RawMessage); !ok || err != nil || string(raw) != `{"a": 1}` {
+		t.Errorf("unknown event should be returned as raw json, got %#v %v", details, err)
+	}
+}
@@ -0,0 +1,79 @@
+// KYT example submits transaction of the applicant for monitoring and prints
+// its risk score and matched rules.
+//
+// Credentials are read from SUMSUB_USER and SUMSUB_PASS environment variables,
+// applicant should be created before, e.g. by the onboarding example:
+//
+//	go run ./examples/kyt -id {applicantId} -amount 1000.50 -currency EUR
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sg3des/sumsub"
+)
+
+func main() {
+	addr := flag.String("addr", sumsub.TestAddr, "sumsub api address")
+	id := flag.String("id", "", "applicant id")
+	txnID := flag.String("txn-id", fmt.Sprintf("example-%d", time.Now().Unix()), "transaction id")
+	amount := flag.String("amount", "1000", "amount of the transaction")
+	currency := flag.String("currency", "EUR", "currency code")
+	direction := flag.String("direction", sumsub.TxnDirectionOut, "direction of the transaction: in or out")
+	flag.Parse()
+
+	if *id == "" {
+		log.Fatal("applicant id is required")
+	}
+
+	value, err := sumsub.ParseDecimal(*amount)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := sumsub.NewClientWithCredentials(*addr, sumsub.EnvCredentials{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	date := sumsub.Time{Time: time.Now().UTC()}
+	txn := sumsub.Transaction{
+		TxnID:   *txnID,
+		Type:    sumsub.TxnTypeFinance,
+		TxnDate: &date,
+		Info: sumsub.TxnInfo{
+			Direction:    *direction,
+			Amount:       value,
+			CurrencyCode: *currency,
+			CurrencyType: sumsub.CurrencyTypeFiat,
+		},
+		Counterparty: &sumsub.TxnParticipant{
+			Type:     sumsub.ApplicantTypeIndividual,
+			FullName: "Jane Doe",
+		},
+	}
+
+	result, err := client.SubmitTransaction(*id, txn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("transaction %s submitted, score: %v, review status: %s", result.ID, result.ScoringResult.Score, result.Review.ReviewStatus)
+
+	for _, rule := range result.ScoringResult.MatchedRules {
+		log.Printf("matched rule %s, score: %v, action: %s", rule.Name, rule.Score, rule.Action)
+	}
+
+	if result.IsOnHold() {
+		log.Println("transaction is held for manual review")
+	}
+
+	// transaction can be requested later by the client id
+	result, err = client.GetTransactionByTxnID(*txnID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("transaction review status:", result.Review.ReviewStatus)
+}
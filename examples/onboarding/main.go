@@ -0,0 +1,85 @@
+// Onboarding example creates applicant, uploads selfie and waits for the
+// review result.
+//
+// Credentials are read from SUMSUB_USER and SUMSUB_PASS environment variables:
+//
+//	go run ./examples/onboarding -selfie testdata/selfie.jpg
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/sg3des/sumsub"
+)
+
+func main() {
+	addr := flag.String("addr", sumsub.TestAddr, "sumsub api address")
+	userID := flag.String("user-id", fmt.Sprintf("example-%d", time.Now().Unix()), "external user id")
+	selfie := flag.String("selfie", "testdata/selfie.jpg", "path to selfie image")
+	wait := flag.Duration("wait", time.Minute, "time to wait for the review result")
+	flag.Parse()
+
+	client, err := sumsub.NewClientWithCredentials(*addr, sumsub.EnvCredentials{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	a := sumsub.Applicant{
+		ExternalUserID: *userID,
+		Info: sumsub.ApplicantInfo{
+			Country:   "GBR",
+			FirstName: "John",
+			LastName:  "Smith",
+		},
+		RequiredIdDocs: sumsub.ApplicantRequiredIDDocs{
+			DocSets: []sumsub.ApplicantDoc{
+				{
+					IDDocSetType: sumsub.IDDocSetType_SELFIE,
+					Types:        []string{sumsub.DocSetType_SELFIE},
+				},
+			},
+		},
+	}
+
+	if err := client.CreateApplicant(&a); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("applicant created:", a.ID)
+
+	f, err := os.Open(*selfie)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	metadata := sumsub.DocumentMetaData{
+		IDDocType: sumsub.DocSetType_SELFIE,
+		Country:   "GBR",
+	}
+
+	doc, err := client.AddDocument(a.ID, metadata, f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("selfie uploaded, image id:", doc.ImageID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *wait)
+	defer cancel()
+
+	status, err := client.WaitForReview(ctx, a.ID, sumsub.PollOptions{MinInterval: 5 * time.Second})
+	if err == context.DeadlineExceeded {
+		log.Println("review is not completed yet, status:", status.ReviewStatus)
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	comment, ok := status.IsPass()
+	log.Printf("review completed, pass: %t %s", ok, comment)
+}
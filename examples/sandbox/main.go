@@ -0,0 +1,71 @@
+// Sandbox example creates applicant in the test environment and simulates
+// review result, it works only with sumsub.TestAddr.
+//
+// Credentials are read from SUMSUB_USER and SUMSUB_PASS environment variables:
+//
+//	go run ./examples/sandbox -answer RED -labels BAD_SELFIE
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/sg3des/sumsub"
+)
+
+func main() {
+	answer := flag.String("answer", sumsub.ReviewResultGREEN, "review answer: GREEN or RED")
+	labels := flag.String("labels", "", "comma separated reject labels for RED answer")
+	rejectType := flag.String("reject-type", "", "reject type for RED answer: FINAL or RETRY")
+	flag.Parse()
+
+	client, err := sumsub.NewClientWithCredentials(sumsub.TestAddr, sumsub.EnvCredentials{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	a := sumsub.Applicant{
+		ExternalUserID: fmt.Sprintf("sandbox-%d", time.Now().Unix()),
+		Info: sumsub.ApplicantInfo{
+			Country:   "GBR",
+			FirstName: "test",
+			LastName:  "test",
+		},
+		RequiredIdDocs: sumsub.ApplicantRequiredIDDocs{
+			DocSets: []sumsub.ApplicantDoc{
+				{
+					IDDocSetType: sumsub.IDDocSetType_SELFIE,
+					Types:        []string{sumsub.DocSetType_SELFIE},
+				},
+			},
+		},
+	}
+
+	if err := client.CreateApplicant(&a); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("applicant created:", a.ID)
+
+	data := sumsub.ApplicantCompleteRequest{
+		ReviewAnswer:     *answer,
+		ReviewRejectType: *rejectType,
+	}
+	if *labels != "" {
+		data.RejectLabels = strings.Split(*labels, ",")
+	}
+
+	if err := client.ApplicantComplete(a.ID, data); err != nil {
+		log.Fatal(err)
+	}
+
+	status, err := client.GetApplicantStatus(a.ID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	comment, ok := status.IsPass()
+	log.Printf("status: %s, pass: %t %s", status.ReviewStatus, ok, comment)
+}
@@ -0,0 +1,72 @@
+// Webhooks example receives sumsub webhooks, verifies their digest with the
+// secret key from the dashboard and logs review results.
+//
+// Secret key is read from SUMSUB_WEBHOOK_SECRET environment variable, webhooks
+// url in the dashboard should point to the listen address and path:
+//
+//	go run ./examples/webhooks -listen :8080 -path /sumsub/webhooks
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/sg3des/sumsub"
+)
+
+func main() {
+	listen := flag.String("listen", ":8080", "http listen address")
+	path := flag.String("path", "/sumsub/webhooks", "path of the webhooks url")
+	flag.Parse()
+
+	secret := os.Getenv("SUMSUB_WEBHOOK_SECRET")
+	if secret == "" {
+		log.Println("SUMSUB_WEBHOOK_SECRET is empty, digest of webhooks is not verified")
+	}
+
+	h := sumsub.NewWebhookHandler(secret)
+
+	// sumsub may deliver the same webhook several times
+	h.Deduplicate(nil)
+
+	h.OnApplicantCreated(func(w *sumsub.ApplicantCreatedWebhook) error {
+		log.Printf("applicant %s created, user id: %s, level: %s", w.ApplicantID, w.ExternalUserID, w.LevelName)
+		return nil
+	})
+
+	h.OnApplicantPending(func(w *sumsub.ApplicantPendingWebhook) error {
+		log.Printf("applicant %s is submitted to review", w.ApplicantID)
+		return nil
+	})
+
+	h.OnApplicantReviewed(func(w *sumsub.ApplicantReviewedWebhook) error {
+		result := w.ReviewResult
+		if result.ReviewAnswer == sumsub.ReviewResultGREEN {
+			log.Printf("applicant %s approved", w.ApplicantID)
+			return nil
+		}
+
+		log.Printf("applicant %s rejected, type: %s, labels: %s, comment: %s",
+			w.ApplicantID, result.ReviewRejectType, strings.Join(result.RejectLabels, ","), result.ModerationComment)
+		return nil
+	})
+
+	h.OnApplicantOnHold(func(w *sumsub.ApplicantOnHoldWebhook) error {
+		log.Printf("review of applicant %s is on hold", w.ApplicantID)
+		return nil
+	})
+
+	h.OnApplicantAMLHitsFound(func(w *sumsub.ApplicantAMLHitsFoundWebhook) error {
+		log.Printf("%d new AML hits of applicant %s", len(w.Hits), w.ApplicantID)
+		return nil
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(*path, h)
+
+	log.Printf("listening webhooks on %s%s", *listen, *path)
+	log.Fatal(http.ListenAndServe(*listen, mux))
+}
@@ -0,0 +1,51 @@
+package sumsub
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// FaceAuthSession is face authentication of the returning applicant, selfie
+// is taken in the SDK initialized with Token and matched against enrolled face
+type FaceAuthSession struct {
+	ExternalActionID string
+	Token            AccessToken
+}
+
+// CreateFaceAuthSession for the applicant with external user id userID,
+// levelName is face authentication level configured in the dashboard. If
+// externalActionID is empty random id is generated
+func (s *SumSub) CreateFaceAuthSession(userID, levelName, externalActionID string, ttl time.Duration) (session FaceAuthSession, err error) {
+	if externalActionID == "" {
+		if externalActionID, err = randomID(); err != nil {
+			return session, err
+		}
+	}
+
+	session.ExternalActionID = externalActionID
+	session.Token, err = s.GenerateAccessTokenWithOptions(AccessTokenOptions{
+		UserID:           userID,
+		LevelName:        levelName,
+		TTL:              ttl,
+		ExternalActionID: externalActionID,
+	})
+
+	return
+}
+
+// GetFaceAuthResult returns action of the face authentication session, review
+// is completed when applicant passed the check in the SDK
+func (s *SumSub) GetFaceAuthResult(session FaceAuthSession) (ApplicantAction, error) {
+	return s.GetApplicantActionByExternalID(session.ExternalActionID)
+}
+
+// randomID generates random hex id
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
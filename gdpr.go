@@ -0,0 +1,47 @@
+package sumsub
+
+// statuses of the applicant data deletion
+const (
+	DeletionStatusPending   = "pending"
+	DeletionStatusCompleted = "completed"
+	DeletionStatusFailed    = "failed"
+)
+
+// DeletionStatus is state of the request to remove personal data
+type DeletionStatus struct {
+	ApplicantID string `json:"applicantId"`
+	Status      string `json:"status"`
+	RequestedAt Time   `json:"requestedAt"`
+	CompletedAt Time   `json:"completedAt,omitempty"`
+}
+
+// IsCompleted returns true if personal data is removed
+func (status DeletionStatus) IsCompleted() bool {
+	return status.Status == DeletionStatusCompleted
+}
+
+// RequestApplicantDeletion requests permanent removal of the applicant
+// personal data, it can't be undone. Removal is performed asynchronously,
+// see GetApplicantDeletionStatus.
+// DELETE /resources/applicants/{applicantId}
+func (s *SumSub) RequestApplicantDeletion(id string) (status DeletionStatus, err error) {
+	resp, err := s.do("DELETE", "resources/applicants/"+id)
+	if err := handleResponse(resp, err); err != nil {
+		return status, err
+	}
+
+	err = s.decode(resp, &status)
+	return
+}
+
+// GetApplicantDeletionStatus returns state of the personal data removal
+// GET /resources/applicants/{applicantId}/deletion
+func (s *SumSub) GetApplicantDeletionStatus(id string) (status DeletionStatus, err error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/deletion")
+	if err := handleResponse(resp, err); err != nil {
+		return status, err
+	}
+
+	err = s.decode(resp, &status)
+	return
+}
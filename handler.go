@@ -0,0 +1,321 @@
+package sumsub
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// digest algorithms of the webhook payload passed in X-Payload-Digest-Alg
+// header
+const (
+	DigestHMACSHA1   = "HMAC_SHA1_HEX"
+	DigestHMACSHA256 = "HMAC_SHA256_HEX"
+	DigestHMACSHA512 = "HMAC_SHA512_HEX"
+)
+
+// maxWebhookSize is limit of the webhook body
+const maxWebhookSize = 1 << 20
+
+// ErrInvalidDigest is returned if webhook digest does not match the payload
+var ErrInvalidDigest = errors.New("invalid webhook digest")
+
+// VerifyWebhookDigest checks HMAC digest of the webhook body, empty alg means
+// HMAC_SHA1_HEX
+func VerifyWebhookDigest(secret, body []byte, digest, alg string) error {
+	var h func() hash.Hash
+	switch alg {
+	case "", DigestHMACSHA1:
+		h = sha1.New
+	case DigestHMACSHA256:
+		h = sha256.New
+	case DigestHMACSHA512:
+		h = sha512.New
+	default:
+		return errors.New("unknown digest algorithm " + alg)
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return ErrInvalidDigest
+	}
+
+	mac := hmac.New(h, secret)
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrInvalidDigest
+	}
+
+	return nil
+}
+
+// WebhookHandler is http.Handler which verifies webhook digest and dispatches
+// payloads to the callbacks registered by webhook type. It responds with 401
+// to invalid digest, 400 to malformed payload and 500 if callback fails, so
+// sumsub retries delivery
+type WebhookHandler struct {
+	secret []byte
+	store  WebhookStore
+	queue  WebhookQueue
+	cached *SumSub
+
+	mu            sync.RWMutex
+	callbacks     map[string][]func(Webhook) error
+	subscriptions []*subscription
+}
+
+// NewWebhookHandler with webhook secret key from the dashboard, empty secret
+// disables digest verification
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		secret:    []byte(secret),
+		callbacks: make(map[string][]func(Webhook) error),
+	}
+}
+
+// Deduplicate skips webhooks already processed according to the store, key
+// is added to the store only if all callbacks succeed. Nil store means
+// in-memory store with keys kept for a day
+func (h *WebhookHandler) Deduplicate(store WebhookStore) {
+	if store == nil {
+		store = NewMemoryWebhookStore(24 * time.Hour)
+	}
+
+	h.store = store
+}
+
+// Handle registers callback for the webhook type, callbacks are called in the
+// order of registration
+func (h *WebhookHandler) Handle(webhookType string, fn func(Webhook) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.callbacks[webhookType] = append(h.callbacks[webhookType], fn)
+}
+
+// OnApplicantCreated registers callback for applicantCreated webhooks
+func (h *WebhookHandler) OnApplicantCreated(fn func(*ApplicantCreatedWebhook) error) {
+	h.Handle(WebhookApplicantCreated, func(w Webhook) error { return fn(w.(*ApplicantCreatedWebhook)) })
+}
+
+// OnApplicantPending registers callback for applicantPending webhooks
+func (h *WebhookHandler) OnApplicantPending(fn func(*ApplicantPendingWebhook) error) {
+	h.Handle(WebhookApplicantPending, func(w Webhook) error { return fn(w.(*ApplicantPendingWebhook)) })
+}
+
+// OnApplicantReviewed registers callback for applicantReviewed webhooks
+func (h *WebhookHandler) OnApplicantReviewed(fn func(*ApplicantReviewedWebhook) error) {
+	h.Handle(WebhookApplicantReviewed, func(w Webhook) error { return fn(w.(*ApplicantReviewedWebhook)) })
+}
+
+// OnApplicantOnHold registers callback for applicantOnHold webhooks
+func (h *WebhookHandler) OnApplicantOnHold(fn func(*ApplicantOnHoldWebhook) error) {
+	h.Handle(WebhookApplicantOnHold, func(w Webhook) error { return fn(w.(*ApplicantOnHoldWebhook)) })
+}
+
+// OnApplicantPersonalInfoChanged registers callback for
+// applicantPersonalInfoChanged webhooks
+func (h *WebhookHandler) OnApplicantPersonalInfoChanged(fn func(*ApplicantPersonalInfoChangedWebhook) error) {
+	h.Handle(WebhookApplicantPersonalInfoChanged, func(w Webhook) error { return fn(w.(*ApplicantPersonalInfoChangedWebhook)) })
+}
+
+// OnApplicantDeleted registers callback for applicantDeleted webhooks
+func (h *WebhookHandler) OnApplicantDeleted(fn func(*ApplicantDeletedWebhook) error) {
+	h.Handle(WebhookApplicantDeleted, func(w Webhook) error { return fn(w.(*ApplicantDeletedWebhook)) })
+}
+
+// OnVideoIdentStatusChanged registers callback for videoIdentStatusChanged
+// webhooks
+func (h *WebhookHandler) OnVideoIdentStatusChanged(fn func(*VideoIdentStatusChangedWebhook) error) {
+	h.Handle(WebhookVideoIdentStatusChanged, func(w Webhook) error { return fn(w.(*VideoIdentStatusChangedWebhook)) })
+}
+
+// OnApplicantActionReviewed registers callback for applicantActionReviewed
+// webhooks
+func (h *WebhookHandler) OnApplicantActionReviewed(fn func(*ApplicantActionReviewedWebhook) error) {
+	h.Handle(WebhookApplicantActionReviewed, func(w Webhook) error { return fn(w.(*ApplicantActionReviewedWebhook)) })
+}
+
+// OnApplicantAMLHitsFound registers callback for applicantAmlHitsFound
+// webhooks of the ongoing monitoring
+func (h *WebhookHandler) OnApplicantAMLHitsFound(fn func(*ApplicantAMLHitsFoundWebhook) error) {
+	h.Handle(WebhookApplicantAMLHitsFound, func(w Webhook) error { return fn(w.(*ApplicantAMLHitsFoundWebhook)) })
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(h.secret) > 0 {
+		err := VerifyWebhookDigest(h.secret, body, r.Header.Get("X-Payload-Digest"), r.Header.Get("X-Payload-Digest-Alg"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	webhook, err := ParseWebhook(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.queue != nil {
+		if err := h.queue.Enqueue(body); err != nil {
+			log.Errorf("webhook %s of applicant %s is not enqueued: %v", webhook.Payload().Type, webhook.Payload().ApplicantID, err)
+			http.Error(w, "webhook is not enqueued", http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := h.process(webhook); err != nil {
+		log.Errorf("webhook %s of applicant %s: %v", webhook.Payload().Type, webhook.Payload().ApplicantID, err)
+		http.Error(w, "webhook is not processed", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// process dispatches webhook if it is not processed yet
+func (h *WebhookHandler) process(webhook Webhook) error {
+	if h.store == nil {
+		return h.Dispatch(webhook)
+	}
+
+	key := WebhookKey(webhook)
+	if exists, err := h.store.Exists(key); err != nil || exists {
+		return err
+	}
+
+	if err := h.Dispatch(webhook); err != nil {
+		return err
+	}
+
+	return h.store.Add(key)
+}
+
+// Dispatch webhook to the callbacks registered for its type and to the
+// subscriptions, first error is returned
+func (h *WebhookHandler) Dispatch(webhook Webhook) error {
+	h.mu.RLock()
+	callbacks := h.callbacks[webhook.Payload().Type]
+	subscriptions := h.subscriptions
+	h.mu.RUnlock()
+
+	if h.cached != nil {
+		if err := h.cached.InvalidateApplicant(webhook.Payload().ApplicantID); err != nil {
+			return err
+		}
+	}
+
+	for _, fn := range callbacks {
+		if err := fn(webhook); err != nil {
+			return err
+		}
+	}
+
+	for _, sub := range subscriptions {
+		if err := sub.send(webhook); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Subscribe returns channel of webhooks of the types, all webhooks are sent
+// if types are not specified. When channel buffer is full, handler waits for
+// the consumer, so slow consumer slows down webhook responses. Channel is
+// closed when ctx is done, webhook which is not delivered at that moment is
+// answered with error to be retried by sumsub
+func (h *WebhookHandler) Subscribe(ctx context.Context, buffer int, types ...string) <-chan Webhook {
+	sub := &subscription{
+		ctx:   ctx,
+		ch:    make(chan Webhook, buffer),
+		types: types,
+	}
+
+	h.mu.Lock()
+	h.subscriptions = append(h.subscriptions, sub)
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.unsubscribe(sub)
+	}()
+
+	return sub.ch
+}
+
+func (h *WebhookHandler) unsubscribe(sub *subscription) {
+	h.mu.Lock()
+	subscriptions := make([]*subscription, 0, len(h.subscriptions))
+	for _, s := range h.subscriptions {
+		if s != sub {
+			subscriptions = append(subscriptions, s)
+		}
+	}
+	h.subscriptions = subscriptions
+	h.mu.Unlock()
+
+	sub.close()
+}
+
+// subscription is channel of webhooks created by Subscribe
+type subscription struct {
+	ctx   context.Context
+	ch    chan Webhook
+	types []string
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func (sub *subscription) send(webhook Webhook) error {
+	if len(sub.types) > 0 && !containsString(sub.types, webhook.Payload().Type) {
+		return nil
+	}
+
+	sub.mu.RLock()
+	defer sub.mu.RUnlock()
+
+	if sub.closed {
+		return nil
+	}
+
+	select {
+	case sub.ch <- webhook:
+		return nil
+	case <-sub.ctx.Done():
+		return sub.ctx.Err()
+	}
+}
+
+func (sub *subscription) close() {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	sub.closed = true
+	close(sub.ch)
+}
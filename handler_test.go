@@ -0,0 +1,121 @@
+package sumsub
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	const secret = "secret"
+	body := `{"type": "applicantReviewed", "applicantId": "id", "reviewResult": {"reviewAnswer": "GREEN"}}`
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	digest := hex.EncodeToString(mac.Sum(nil))
+
+	h := NewWebhookHandler(secret)
+
+	var reviewed *ApplicantReviewedWebhook
+	var fail bool
+	h.OnApplicantReviewed(func(w *ApplicantReviewedWebhook) error {
+		reviewed = w
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	send := func(body, digest string) int {
+		r := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+		r.Header.Set("X-Payload-Digest", digest)
+		r.Header.Set("X-Payload-Digest-Alg", DigestHMACSHA256)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := send(body, digest); code != http.StatusOK {
+		t.Error("unexpected status", code)
+	}
+	if reviewed == nil || reviewed.ReviewResult.ReviewAnswer != ReviewResultGREEN {
+		t.Errorf("callback is not called %+v", reviewed)
+	}
+
+	if code := send(body, strings.Repeat("0", len(digest))); code != http.StatusUnauthorized {
+		t.Error("expected 401 for invalid digest, got", code)
+	}
+
+	fail = true
+	if code := send(body, digest); code != http.StatusInternalServerError {
+		t.Error("expected 500 for failed callback, got", code)
+	}
+
+	mac.Reset()
+	mac.Write([]byte("{}"))
+	if code := send("{}", hex.EncodeToString(mac.Sum(nil))); code != http.StatusBadRequest {
+		t.Error("expected 400 for malformed payload, got", code)
+	}
+}
+
+func TestWebhookHandlerDeduplicate(t *testing.T) {
+	h := NewWebhookHandler("")
+	h.Deduplicate(nil)
+
+	var calls int
+	h.OnApplicantPending(func(*ApplicantPendingWebhook) error {
+		calls++
+		return nil
+	})
+
+	send := func(body string) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", strings.NewReader(body)))
+		if w.Code != http.StatusOK {
+			t.Error("unexpected status", w.Code)
+		}
+	}
+
+	body := `{"type": "applicantPending", "applicantId": "id", "correlationId": "c1", "createdAtMs": "2020-02-21 13:23:19.321"}`
+	send(body)
+	send(body)
+	send(strings.Replace(body, "c1", "c2", 1))
+
+	if calls != 2 {
+		t.Error("duplicate webhook is processed, calls:", calls)
+	}
+}
+
+func TestWebhookHandlerSubscribe(t *testing.T) {
+	h := NewWebhookHandler("")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := h.Subscribe(ctx, 1, WebhookApplicantReviewed)
+
+	for _, body := range []string{
+		`{"type": "applicantPending", "applicantId": "id"}`,
+		`{"type": "applicantReviewed", "applicantId": "id"}`,
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", strings.NewReader(body)))
+		if w.Code != http.StatusOK {
+			t.Error("unexpected status", w.Code)
+		}
+	}
+
+	if webhook := <-events; webhook.Payload().Type != WebhookApplicantReviewed {
+		t.Error("wrong webhook type", webhook.Payload().Type)
+	}
+
+	cancel()
+	if _, ok := <-events; ok {
+		t.Error("channel is not closed")
+	}
+}
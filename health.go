@@ -0,0 +1,55 @@
+package sumsub
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUnavailable is returned by Ping if sumsub api does not respond
+var ErrUnavailable = errors.New("sumsub api is unavailable")
+
+// Ping checks that sumsub api is reachable, credentials are not used
+// GET /resources/status/api
+func (s *SumSub) Ping() error {
+	resp, err := s.httpClient().Get(s.URL("resources/status/api"))
+	if err != nil {
+		return ErrUnavailable
+	}
+	if r := resp.Response(); r.StatusCode != 200 {
+		return ErrUnavailable
+	}
+
+	return nil
+}
+
+// HealthStatus is state of the sumsub api and client credentials
+type HealthStatus struct {
+	// Available is true if api responds
+	Available bool
+
+	// Authenticated is true if authorized request succeeded with current
+	// credentials
+	Authenticated bool
+
+	// Latency is duration of the authorized request
+	Latency time.Duration
+}
+
+// Health checks api availability and credentials, error describes failed
+// check: ErrUnavailable if api is down, otherwise authentication or request
+// error
+func (s *SumSub) Health() (status HealthStatus, err error) {
+	if err := s.Ping(); err != nil {
+		return status, err
+	}
+	status.Available = true
+
+	start := time.Now()
+	if _, err := s.ListLevels(); err != nil {
+		return status, err
+	}
+
+	status.Authenticated = true
+	status.Latency = time.Since(start)
+	return status, nil
+}
@@ -0,0 +1,208 @@
+package sumsub
+
+import (
+	"net/url"
+
+	"github.com/imroc/req"
+)
+
+// transaction directions
+const (
+	TxnDirectionIn  = "in"
+	TxnDirectionOut = "out"
+)
+
+// currency types of the transaction
+const (
+	CurrencyTypeFiat   = "fiat"
+	CurrencyTypeCrypto = "crypto"
+)
+
+// transaction types
+const (
+	TxnTypeFinance           = "finance"
+	TxnTypeKYC               = "kyc"
+	TxnTypeTravelRule        = "travelRule"
+	TxnTypeUserPlatformEvent = "userPlatformEvent"
+)
+
+// Transaction is financial transaction submitted for KYT monitoring
+type Transaction struct {
+	// TxnID is unique id of the transaction assigned by the client
+	TxnID string `json:"txnId"`
+	Type  string `json:"type,omitempty"`
+
+	// TxnDate is time of the transaction, time of submission is used by
+	// sumsub if it is nil
+	TxnDate *Time `json:"txnDate,omitempty"`
+
+	Info         TxnInfo         `json:"info"`
+	Applicant    TxnParticipant  `json:"applicant"`
+	Counterparty *TxnParticipant `json:"counterparty,omitempty"`
+
+	// Props are custom properties of the transaction used by the rules
+	Props map[string]string `json:"props,omitempty"`
+}
+
+// TxnInfo is amount and direction of the transaction
+type TxnInfo struct {
+	// Direction is TxnDirectionIn or TxnDirectionOut relative to the applicant
+	Direction    string  `json:"direction"`
+	Amount       Decimal `json:"amount"`
+	CurrencyCode string  `json:"currencyCode"`
+	CurrencyType string  `json:"currencyType,omitempty"`
+
+	PaymentDetails string `json:"paymentDetails,omitempty"`
+
+	// CryptoParams are set for crypto transactions
+	CryptoParams *TxnCryptoParams `json:"cryptoParams,omitempty"`
+}
+
+// TxnCryptoParams are blockchain details of the crypto transaction
+type TxnCryptoParams struct {
+	CryptoChain string `json:"cryptoChain,omitempty"`
+	TxnHash     string `json:"txnHash,omitempty"`
+}
+
+// TxnParticipant is applicant or counterparty of the transaction
+type TxnParticipant struct {
+	ExternalUserID string   `json:"externalUserId,omitempty"`
+	Type           string   `json:"type,omitempty"`
+	FullName       string   `json:"fullName,omitempty"`
+	DateOfBirth    Date     `json:"dob,omitempty"`
+	Address        *Address `json:"address,omitempty"`
+
+	PaymentMethod   *TxnPaymentMethod   `json:"paymentMethod,omitempty"`
+	InstitutionInfo *TxnInstitutionInfo `json:"institutionInfo,omitempty"`
+}
+
+// TxnPaymentMethod is account of the participant, e.g. card, bank account or
+// crypto wallet address
+type TxnPaymentMethod struct {
+	Type           string `json:"type"`
+	AccountID      string `json:"accountId"`
+	IssuingCountry string `json:"issuingCountry,omitempty"`
+}
+
+// TxnInstitutionInfo is financial institution of the participant
+type TxnInstitutionInfo struct {
+	Code    string   `json:"code,omitempty"`
+	Name    string   `json:"name,omitempty"`
+	Address *Address `json:"address,omitempty"`
+}
+
+// TransactionResult is transaction registered by sumsub with its scoring
+type TransactionResult struct {
+	ID          string      `json:"id"`
+	ApplicantID string      `json:"applicantId"`
+	CreatedAt   Time        `json:"createdAt"`
+	Data        Transaction `json:"data"`
+
+	ScoringResult TxnScoringResult `json:"scoringResult"`
+	Review        ApplicantReview  `json:"review"`
+
+	Tags []string `json:"tags,omitempty"`
+}
+
+// TxnScoringResult is risk score of the transaction and rules it matched
+type TxnScoringResult struct {
+	Score        float64      `json:"score"`
+	MatchedRules []TxnRuleHit `json:"matchedRules,omitempty"`
+}
+
+// TxnRuleHit is monitoring rule matched by the transaction
+type TxnRuleHit struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Title string  `json:"title,omitempty"`
+	Score float64 `json:"score"`
+
+	// Action is action of the rule, e.g. onHold or reject
+	Action string `json:"action,omitempty"`
+}
+
+// IsOnHold reports whether transaction is held for manual review
+func (r TransactionResult) IsOnHold() bool {
+	return r.Review.ReviewStatus == ReviewStatusOnHold
+}
+
+// SubmitTransaction for monitoring of the applicant, result contains scoring
+// and review status of the transaction
+// POST /resources/applicants/{applicantId}/kyt/txns/-/data
+func (s *SumSub) SubmitTransaction(id string, txn Transaction) (result TransactionResult, err error) {
+	resp, err := s.do("POST", "resources/applicants/"+url.PathEscape(id)+"/kyt/txns/-/data", req.BodyJSON(txn))
+	if err := handleResponse(resp, err); err != nil {
+		return result, err
+	}
+
+	err = s.decode(resp, &result)
+	return
+}
+
+// GetTransaction by id assigned by sumsub
+// GET /resources/kyt/txns/{txnId}/one
+func (s *SumSub) GetTransaction(id string) (result TransactionResult, err error) {
+	resp, err := s.do("GET", "resources/kyt/txns/"+url.PathEscape(id)+"/one")
+	if err := handleResponse(resp, err); err != nil {
+		return result, err
+	}
+
+	err = s.decode(resp, &result)
+	return
+}
+
+// GetTransactionByTxnID returns transaction by id assigned by the client
+// GET /resources/kyt/txns/-;data.txnId={txnId}/one
+func (s *SumSub) GetTransactionByTxnID(txnID string) (result TransactionResult, err error) {
+	resp, err := s.do("GET", "resources/kyt/txns/-;data.txnId="+url.PathEscape(txnID)+"/one")
+	if err := handleResponse(resp, err); err != nil {
+		return result, err
+	}
+
+	err = s.decode(resp, &result)
+	return
+}
+
+// UpdateTransactionProps sets custom properties of the transaction, only
+// specified properties are changed
+// PATCH /resources/kyt/txns/{txnId}/data/props
+func (s *SumSub) UpdateTransactionProps(id string, props map[string]string) error {
+	resp, err := s.do("PATCH", "resources/kyt/txns/"+url.PathEscape(id)+"/data/props", req.BodyJSON(props))
+	return handleResponse(resp, err)
+}
+
+// SetTransactionTags replaces tags of the transaction, e.g. chargeback
+// POST /resources/kyt/txns/{txnId}/tags
+func (s *SumSub) SetTransactionTags(id string, tags []string) error {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	resp, err := s.do("POST", "resources/kyt/txns/"+url.PathEscape(id)+"/tags", req.BodyJSON(tags))
+	return handleResponse(resp, err)
+}
+
+// AddTransactionNote attaches note to the transaction
+// POST /resources/kyt/txns/{txnId}/notes
+func (s *SumSub) AddTransactionNote(id, text string) (note Note, err error) {
+	resp, err := s.do("POST", "resources/kyt/txns/"+url.PathEscape(id)+"/notes", req.BodyJSON(Note{Note: text}))
+	if err := handleResponse(resp, err); err != nil {
+		return note, err
+	}
+
+	err = s.decode(resp, &note)
+	return
+}
+
+// RescoreTransaction requests new scoring of the transaction, e.g. after
+// applicant data is changed, updated result is returned
+// POST /resources/kyt/txns/{txnId}/score
+func (s *SumSub) RescoreTransaction(id string) (result TransactionResult, err error) {
+	resp, err := s.do("POST", "resources/kyt/txns/"+url.PathEscape(id)+"/score")
+	if err := handleResponse(resp, err); err != nil {
+		return result, err
+	}
+
+	err = s.decode(resp, &result)
+	return
+}
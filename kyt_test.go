@@ -0,0 +1,112 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransactionJSON(t *testing.T) {
+	date, _ := ParseTime("2021-06-01 12:00:00")
+	txn := Transaction{
+		TxnID:   "txn-1",
+		TxnDate: &date,
+		Type:    TxnTypeFinance,
+		Info: TxnInfo{
+			Direction:    TxnDirectionOut,
+			Amount:       MustDecimal("1000.50"),
+			CurrencyCode: "BTC",
+			CurrencyType: CurrencyTypeCrypto,
+			CryptoParams: &TxnCryptoParams{CryptoChain: "BTC"},
+		},
+		Applicant: TxnParticipant{
+			ExternalUserID: "user-1",
+			PaymentMethod:  &TxnPaymentMethod{Type: "crypto", AccountID: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"},
+		},
+	}
+
+	data, err := json.Marshal(txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{`"amount":1000.50`, `"txnDate":"2021-06-01 12:00:00"`, `"cryptoChain":"BTC"`} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("%s not found in %s", s, data)
+		}
+	}
+	if strings.Contains(string(data), "counterparty") {
+		t.Error("empty counterparty should be omitted")
+	}
+
+	var result TransactionResult
+	if err := json.Unmarshal([]byte(`{"id": "t1", "scoringResult": {"score": 75.5, "matchedRules": [{"id": "r1", "name": "large-withdrawal", "score": 50, "action": "onHold"}]}, "review": {"reviewStatus": "onHold"}, "data": `+string(data)+`}`), &result); err != nil {
+		t.Fatal(err)
+	}
+	if !result.IsOnHold() || result.Data.Info.Amount != "1000.50" || len(result.ScoringResult.MatchedRules) != 1 {
+		t.Errorf("wrong result %+v", result)
+	}
+}
+
+func TestTravelRuleTransfer(t *testing.T) {
+	transfer := TravelRuleTransfer{
+		TxnID:       "w-1",
+		Direction:   TxnDirectionIn,
+		Amount:      MustDecimal("0.5"),
+		Currency:    "BTC",
+		CryptoChain: "BTC",
+		Originator:  TravelRuleParty{FullName: "Alice", WalletAddress: "addr-a", VASP: TxnInstitutionInfo{Name: "Other VASP"}},
+		Beneficiary: TravelRuleParty{ExternalUserID: "user-1", WalletAddress: "addr-b"},
+	}
+
+	txn := transfer.Transaction()
+	if txn.Type != TxnTypeTravelRule || txn.Applicant.ExternalUserID != "user-1" || txn.Counterparty.FullName != "Alice" {
+		t.Errorf("wrong transaction %+v", txn)
+	}
+	if txn.Counterparty.InstitutionInfo.Name != "Other VASP" || txn.Applicant.PaymentMethod.AccountID != "addr-b" {
+		t.Errorf("wrong participants %+v %+v", txn.Applicant, txn.Counterparty)
+	}
+
+	var result TransactionResult
+	if result.TravelRuleStatus() != TravelRuleAwaiting {
+		t.Error("expected awaiting status")
+	}
+	result.Review.ReviewStatus = ReviewStatusCompleted
+	result.Review.ReviewResult.ReviewAnswer = ReviewResultGREEN
+	if result.TravelRuleStatus() != TravelRuleConfirmed {
+		t.Error("expected confirmed status")
+	}
+}
+
+func TestTransactionPathEscape(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		w.Write([]byte(`{"id": "t1"}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+
+	if _, err := s.GetTransactionByTxnID("a/b;c?d"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetTransaction("../x"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"/resources/kyt/txns/-;data.txnId=a%2Fb%3Bc%3Fd/one",
+		"/resources/kyt/txns/..%2Fx/one",
+	}
+	for i, p := range expected {
+		if i >= len(paths) || paths[i] != p {
+			t.Errorf("wrong path %d: %v", i, paths)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package sumsub
+
+import "errors"
+
+// ErrLevelNotFound is returned if level is not configured in the dashboard
+var ErrLevelNotFound = errors.New("level not found")
+
+// Level is verification level configured in the dashboard
+type Level struct {
+	ID             string                  `json:"id"`
+	Name           string                  `json:"name"`
+	Description    string                  `json:"desc,omitempty"`
+	RequiredIdDocs ApplicantRequiredIDDocs `json:"requiredIdDocs"`
+	CreatedAt      Time                    `json:"createdAt"`
+	ModifiedAt     Time                    `json:"modifiedAt,omitempty"`
+}
+
+// ListLevels returns verification levels configured in the dashboard
+// GET /resources/applicants/-/levels
+func (s *SumSub) ListLevels() ([]Level, error) {
+	resp, err := s.do("GET", "resources/applicants/-/levels")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		List struct {
+			Items []Level `json:"items"`
+		} `json:"list"`
+	}
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return list.List.Items, err
+}
+
+// GetLevel by name, ErrLevelNotFound is returned if level does not exist. It
+// can be used to check level names referenced by the code on startup
+func (s *SumSub) GetLevel(name string) (l Level, err error) {
+	levels, err := s.ListLevels()
+	if err != nil {
+		return l, err
+	}
+
+	for _, l := range levels {
+		if l.Name == name {
+			return l, nil
+		}
+	}
+
+	return l, ErrLevelNotFound
+}
@@ -0,0 +1,63 @@
+package sumsub
+
+import (
+	"sort"
+)
+
+// MetadataItem is custom key/value data attached to the applicant
+type MetadataItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Metadata is list of custom data attached to the applicant
+type Metadata []MetadataItem
+
+// NewMetadata converts map to metadata sorted by keys
+func NewMetadata(m map[string]string) Metadata {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	md := make(Metadata, len(keys))
+	for i, key := range keys {
+		md[i] = MetadataItem{Key: key, Value: m[key]}
+	}
+
+	return md
+}
+
+// Map converts metadata to map, for duplicated keys the last value is used
+func (md Metadata) Map() map[string]string {
+	m := make(map[string]string, len(md))
+	for _, item := range md {
+		m[item.Key] = item.Value
+	}
+
+	return m
+}
+
+// Get value by key
+func (md Metadata) Get(key string) (string, bool) {
+	for _, item := range md {
+		if item.Key == key {
+			return item.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Set value by key, existing value is replaced
+func (md *Metadata) Set(key, value string) {
+	for i, item := range *md {
+		if item.Key == key {
+			(*md)[i].Value = value
+			return
+		}
+	}
+
+	*md = append(*md, MetadataItem{Key: key, Value: value})
+}
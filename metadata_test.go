@@ -0,0 +1,37 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMetadata(t *testing.T) {
+	a := Applicant{
+		Metadata: NewMetadata(map[string]string{"source": "web", "campaign": "spring"}),
+	}
+	a.Metadata.Set("source", "mobile")
+	a.Metadata.Set("ref", "123")
+
+	data, err := json.Marshal(a.Metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `[{"key":"campaign","value":"spring"},{"key":"source","value":"mobile"},{"key":"ref","value":"123"}]`
+	if string(data) != expected {
+		t.Error("wrong encoding", string(data))
+	}
+
+	var decoded Applicant
+	if err := json.Unmarshal([]byte(`{"metadata":`+expected+`}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := decoded.Metadata.Get("ref"); !ok || v != "123" {
+		t.Error("metadata is not decoded", decoded.Metadata)
+	}
+
+	if m := decoded.Metadata.Map(); len(m) != 3 || m["source"] != "mobile" {
+		t.Error("wrong map", m)
+	}
+}
@@ -0,0 +1,46 @@
+package sumsub
+
+import (
+	"github.com/imroc/req"
+)
+
+// Note is moderation note attached to the applicant
+type Note struct {
+	ID          string `json:"id,omitempty"`
+	ApplicantID string `json:"applicantId,omitempty"`
+	Note        string `json:"note"`
+	CreatedAt   *Time  `json:"createdAt,omitempty"`
+	CreatedBy   string `json:"createdBy,omitempty"`
+}
+
+// AddApplicantNote attaches note to the applicant, it is visible for sumsub
+// reviewers in the dashboard
+// POST /resources/applicants/{applicantId}/notes
+func (s *SumSub) AddApplicantNote(id, text string) (note Note, err error) {
+	resp, err := s.do("POST", "resources/applicants/"+id+"/notes", req.BodyJSON(Note{Note: text}))
+	if err := handleResponse(resp, err); err != nil {
+		return note, err
+	}
+
+	err = s.decode(resp, &note)
+	return
+}
+
+// GetApplicantNotes returns all notes attached to the applicant
+// GET /resources/applicants/{applicantId}/notes
+func (s *SumSub) GetApplicantNotes(id string) ([]Note, error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/notes")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var notes struct {
+		Items []Note `json:"items"`
+	}
+	err = s.decode(resp, &notes)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return notes.Items, err
+}
@@ -0,0 +1,83 @@
+package sumsub
+
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+// Observer receives events of the client, it is used to collect metrics, e.g.
+// by sumsubprom package. Methods are called synchronously and should not block
+type Observer interface {
+	// ObserveRequest is called after each api request, endpoint is url path
+	// with ids replaced by {id}, status is 0 if response is not received
+	ObserveRequest(method, endpoint string, status int, duration time.Duration)
+
+	// ObserveTokenRefresh is called after each attempt to obtain token
+	ObserveTokenRefresh(err error)
+
+	// ObserveUpload is called with count of the content bytes of the
+	// successfully uploaded document
+	ObserveUpload(bytes int64)
+
+	// ObserveRetry is called before request is sent again, status is code of
+	// the rejected attempt, see WithRetry
+	ObserveRetry(method, endpoint string, status int)
+
+	// ObserveRateLimitWait is called when request is delayed by the rate limit
+	// set by WithRateLimit, wait is duration of the delay
+	ObserveRateLimitWait(method, endpoint string, wait time.Duration)
+}
+
+// WithObserver option sets observer of the client events
+func WithObserver(o Observer) Option {
+	return func(s *SumSub) {
+		s.observer = o
+	}
+}
+
+// observeRequest reports request to the observer if it is set
+func (s *SumSub) observeRequest(method, urlpath string, status int, start time.Time) {
+	if s.observer != nil {
+		s.observer.ObserveRequest(method, endpoint(urlpath), status, time.Since(start))
+	}
+}
+
+// endpoint replaces segments of the url path which are not words, e.g.
+// applicant ids, with {id} placeholder to keep cardinality of metrics low
+func endpoint(urlpath string) string {
+	segments := strings.Split(strings.Trim(urlpath, "/"), "/")
+	for i, segment := range segments {
+		if segment != "-" && !isWord(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func isWord(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// countingReader counts bytes read from r
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
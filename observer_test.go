@@ -0,0 +1,80 @@
+package sumsub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type testObserver struct {
+	requests []string
+	status   []int
+	retries  int
+	waits    int
+}
+
+func (o *testObserver) ObserveRequest(method, endpoint string, status int, duration time.Duration) {
+	o.requests = append(o.requests, method+" "+endpoint)
+	o.status = append(o.status, status)
+}
+
+func (o *testObserver) ObserveTokenRefresh(err error) {}
+
+func (o *testObserver) ObserveUpload(bytes int64) {}
+
+func (o *testObserver) ObserveRetry(method, endpoint string, status int) {
+	o.retries++
+}
+
+func (o *testObserver) ObserveRateLimitWait(method, endpoint string, wait time.Duration) {
+	o.waits++
+}
+
+func TestEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"resources/applicants/5cb56e8e0a975a35f333cb83/status": "/resources/applicants/{id}/status",
+		"/resources/applicants/-;externalUserId=user-1/one":    "/resources/applicants/{id}/one",
+		"resources/applicants/-/levels":                        "/resources/applicants/-/levels",
+		"resources/accessTokens":                               "/resources/accessTokens",
+	}
+
+	for urlpath, expected := range tests {
+		if got := endpoint(urlpath); got != expected {
+			t.Errorf("%s: expected %s, got %s", urlpath, expected, got)
+		}
+	}
+}
+
+func TestObserver(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/resources/throttled" {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	o := &testObserver{}
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour), observer: o}
+
+	s.GetApplicantStatus("5cb56e8e0a975a35f333cb83")
+
+	if len(o.requests) != 1 || o.requests[0] != "GET /resources/applicants/{id}/status" || o.status[0] != 404 {
+		t.Error("unexpected observed requests", o.requests, o.status)
+	}
+
+	WithRateLimit(1000, 1)(s)
+	WithRetry(2)(s)
+	s.Do(context.Background(), "GET", "resources/throttled", nil, nil, nil)
+	s.Do(context.Background(), "GET", "resources/throttled", nil, nil, nil)
+
+	if o.retries != 4 || o.waits == 0 {
+		t.Error("retries and rate limit waits are not observed", o.retries, o.waits)
+	}
+}
@@ -0,0 +1,155 @@
+package sumsub
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxOwnershipDepth limits nesting of the ownership tree to protect from
+// cyclic ownership
+const maxOwnershipDepth = 10
+
+// OwnershipNode is applicant in the beneficial ownership tree, company nodes
+// have owners and other related persons as Children
+type OwnershipNode struct {
+	ApplicantID string
+	Name        string
+	Type        string
+
+	// Types are beneficiary types in the parent company, e.g. ubo or director
+	Types []string
+
+	// ShareSize is percentage of the parent company shares
+	ShareSize float64
+
+	Children []*OwnershipNode
+}
+
+// IsCompany reports whether node is company
+func (n *OwnershipNode) IsCompany() bool {
+	return n.Type == ApplicantTypeCompany
+}
+
+// Walk calls fn for the node and its descendants in depth-first order, path
+// is chain of nodes from the root to the parent of the node. Walking stops if
+// fn returns false
+func (n *OwnershipNode) Walk(fn func(node *OwnershipNode, path []*OwnershipNode) bool) {
+	n.walk(nil, fn)
+}
+
+func (n *OwnershipNode) walk(path []*OwnershipNode, fn func(*OwnershipNode, []*OwnershipNode) bool) bool {
+	if !fn(n, path) {
+		return false
+	}
+
+	path = append(path[:len(path):len(path)], n)
+	for _, child := range n.Children {
+		if !child.walk(path, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BeneficialOwner is natural person with effective share in the root company
+type BeneficialOwner struct {
+	Node *OwnershipNode
+
+	// Share is effective percentage of the root company owned directly and
+	// through intermediate companies
+	Share float64
+}
+
+// NaturalPersons returns all individuals of the tree with effective shares,
+// person reachable by several paths is returned once with summed share
+func (n *OwnershipNode) NaturalPersons() []BeneficialOwner {
+	var owners []BeneficialOwner
+	index := make(map[string]int)
+
+	n.Walk(func(node *OwnershipNode, path []*OwnershipNode) bool {
+		if node.IsCompany() || len(path) == 0 {
+			return true
+		}
+
+		share := node.ShareSize
+		for _, parent := range path[1:] {
+			share = share * parent.ShareSize / 100
+		}
+
+		key := node.ApplicantID
+		if key == "" {
+			key = strings.ToLower(node.Name)
+		}
+
+		if i, ok := index[key]; ok {
+			owners[i].Share += share
+			return true
+		}
+
+		index[key] = len(owners)
+		owners = append(owners, BeneficialOwner{Node: node, Share: share})
+		return true
+	})
+
+	return owners
+}
+
+// AllNaturalPersonsWithShareAbove returns individuals owning more than
+// percent of the root company, sorted by share in descending order
+func (n *OwnershipNode) AllNaturalPersonsWithShareAbove(percent float64) []BeneficialOwner {
+	var owners []BeneficialOwner
+	for _, owner := range n.NaturalPersons() {
+		if owner.Share > percent {
+			owners = append(owners, owner)
+		}
+	}
+
+	sort.SliceStable(owners, func(i, j int) bool {
+		return owners[i].Share > owners[j].Share
+	})
+
+	return owners
+}
+
+// GetOwnershipTree builds ownership tree of the company applicant by fetching
+// its beneficiaries recursively
+func (s *SumSub) GetOwnershipTree(id string) (*OwnershipNode, error) {
+	return s.ownershipNode(Beneficiary{ApplicantID: id}, 0)
+}
+
+func (s *SumSub) ownershipNode(b Beneficiary, depth int) (*OwnershipNode, error) {
+	a, err := s.GetApplicant(b.ApplicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &OwnershipNode{
+		ApplicantID: a.ID,
+		Name:        strings.TrimSpace(a.Info.FirstName + " " + a.Info.LastName),
+		Type:        a.Type,
+		Types:       b.Types,
+		ShareSize:   b.ShareSize,
+	}
+
+	info := a.Info.CompanyInfo
+	if info == nil {
+		return node, nil
+	}
+
+	node.Type = ApplicantTypeCompany
+	node.Name = info.CompanyName
+	if depth >= maxOwnershipDepth {
+		return node, nil
+	}
+
+	for _, beneficiary := range info.Beneficiaries {
+		child, err := s.ownershipNode(beneficiary, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
@@ -0,0 +1,47 @@
+package sumsub
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOwnershipTree(t *testing.T) {
+	alice := func(share float64) *OwnershipNode {
+		return &OwnershipNode{ApplicantID: "alice", Name: "Alice", ShareSize: share, Types: []string{BeneficiaryTypeUBO}}
+	}
+
+	root := &OwnershipNode{
+		ApplicantID: "root",
+		Type:        ApplicantTypeCompany,
+		Children: []*OwnershipNode{
+			alice(10),
+			{ApplicantID: "bob", Name: "Bob", ShareSize: 30},
+			{ApplicantID: "carol", Name: "Carol", Types: []string{BeneficiaryTypeDirector}},
+			{
+				ApplicantID: "holding",
+				Type:        ApplicantTypeCompany,
+				ShareSize:   60,
+				Children: []*OwnershipNode{
+					alice(50),
+					{ApplicantID: "dave", Name: "Dave", ShareSize: 40},
+				},
+			},
+		},
+	}
+
+	owners := root.AllNaturalPersonsWithShareAbove(25)
+	if len(owners) != 2 {
+		t.Fatalf("wrong owners %+v", owners)
+	}
+
+	if owners[0].Node.ApplicantID != "alice" || math.Abs(owners[0].Share-40) > 1e-9 {
+		t.Errorf("wrong first owner %s %v", owners[0].Node.ApplicantID, owners[0].Share)
+	}
+	if owners[1].Node.ApplicantID != "bob" || owners[1].Share != 30 {
+		t.Errorf("wrong second owner %s %v", owners[1].Node.ApplicantID, owners[1].Share)
+	}
+
+	if persons := root.NaturalPersons(); len(persons) != 4 {
+		t.Errorf("expected 4 natural persons, got %d", len(persons))
+	}
+}
@@ -0,0 +1,143 @@
+package sumsub
+
+import (
+	"net/url"
+
+	"github.com/imroc/req"
+)
+
+// Questionnaire is answers of the applicant to the questionnaire configured
+// for the level, answers are grouped by sections and items ids
+type Questionnaire struct {
+	ID       string                          `json:"id"`
+	Sections map[string]QuestionnaireSection `json:"sections"`
+}
+
+type QuestionnaireSection struct {
+	Items map[string]QuestionnaireItem `json:"items"`
+}
+
+// QuestionnaireItem is answer to the question, Values is used for questions
+// with multiple choice
+type QuestionnaireItem struct {
+	Value  string   `json:"value,omitempty"`
+	Values []string `json:"values,omitempty"`
+}
+
+// NewQuestionnaire prepare empty answers to the questionnaire
+func NewQuestionnaire(id string) *Questionnaire {
+	return &Questionnaire{
+		ID:       id,
+		Sections: make(map[string]QuestionnaireSection),
+	}
+}
+
+// Set answer to the question
+func (q *Questionnaire) Set(section, item, value string) {
+	q.setItem(section, item, QuestionnaireItem{Value: value})
+}
+
+// SetValues sets answers to the multiple choice question
+func (q *Questionnaire) SetValues(section, item string, values ...string) {
+	q.setItem(section, item, QuestionnaireItem{Values: values})
+}
+
+// Get answer to the question
+func (q *Questionnaire) Get(section, item string) (QuestionnaireItem, bool) {
+	answer, ok := q.Sections[section].Items[item]
+	return answer, ok
+}
+
+func (q *Questionnaire) setItem(section, item string, answer QuestionnaireItem) {
+	if q.Sections == nil {
+		q.Sections = make(map[string]QuestionnaireSection)
+	}
+
+	sec, ok := q.Sections[section]
+	if !ok {
+		sec.Items = make(map[string]QuestionnaireItem)
+		q.Sections[section] = sec
+	}
+
+	sec.Items[item] = answer
+}
+
+// SubmitQuestionnaire creates or updates answers of the applicant to the
+// questionnaire
+// POST /resources/applicants/{applicantId}/questionnaires
+func (s *SumSub) SubmitQuestionnaire(id string, q Questionnaire) error {
+	resp, err := s.do("POST", "resources/applicants/"+id+"/questionnaires", req.BodyJSON(q))
+	return handleResponse(resp, err)
+}
+
+// QuestionnaireDefinition is schema of the questionnaire configured for the
+// level
+type QuestionnaireDefinition struct {
+	ID       string                       `json:"id"`
+	Title    string                       `json:"title"`
+	Desc     string                       `json:"desc,omitempty"`
+	Sections []QuestionnaireSectionSchema `json:"sections"`
+}
+
+type QuestionnaireSectionSchema struct {
+	ID    string                    `json:"id"`
+	Title string                    `json:"title"`
+	Desc  string                    `json:"desc,omitempty"`
+	Items []QuestionnaireItemSchema `json:"items"`
+}
+
+type QuestionnaireItemSchema struct {
+	ID       string                `json:"id"`
+	Title    string                `json:"title"`
+	Desc     string                `json:"desc,omitempty"`
+	Type     string                `json:"type"`
+	Required bool                  `json:"required"`
+	Options  []QuestionnaireOption `json:"options,omitempty"`
+}
+
+type QuestionnaireOption struct {
+	Value string `json:"value"`
+	Title string `json:"title"`
+}
+
+// Section returns section schema by id
+func (def QuestionnaireDefinition) Section(id string) (QuestionnaireSectionSchema, bool) {
+	for _, sec := range def.Sections {
+		if sec.ID == id {
+			return sec, true
+		}
+	}
+
+	return QuestionnaireSectionSchema{}, false
+}
+
+// GetQuestionnaireDefinitions returns schema of the questionnaires configured
+// for the level
+// GET /resources/levels/{levelName}/questionnaires
+func (s *SumSub) GetQuestionnaireDefinitions(levelName string) ([]QuestionnaireDefinition, error) {
+	resp, err := s.do("GET", "resources/levels/"+url.PathEscape(levelName)+"/questionnaires")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var defs struct {
+		Items []QuestionnaireDefinition `json:"items"`
+	}
+	err = s.decode(resp, &defs)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return defs.Items, err
+}
+
+// GetApplicantQuestionnaires returns answers submitted by the applicant, in
+// WebSDK or with SubmitQuestionnaire
+func (s *SumSub) GetApplicantQuestionnaires(id string) ([]Questionnaire, error) {
+	a, err := s.GetApplicant(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Questionnaires, nil
+}
@@ -0,0 +1,53 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestQuestionnaire(t *testing.T) {
+	q := NewQuestionnaire("kyc")
+	q.Set("finance", "sourceOfFunds", "salary")
+	q.SetValues("finance", "currencies", "EUR", "USD")
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"id":"kyc","sections":{"finance":{"items":{"currencies":{"values":["EUR","USD"]},"sourceOfFunds":{"value":"salary"}}}}}`
+	if string(data) != expected {
+		t.Error("wrong encoding", string(data))
+	}
+
+	if answer, ok := q.Get("finance", "sourceOfFunds"); !ok || answer.Value != "salary" {
+		t.Error("answer not found")
+	}
+
+	if _, ok := q.Get("unknown", "item"); ok {
+		t.Error("unexpected answer")
+	}
+}
+
+func TestQuestionnaireDefinitionsPath(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Write([]byte(`{"items": []}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+
+	if _, err := s.GetQuestionnaireDefinitions("kyc level/eu"); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/resources/levels/kyc%20level%2Feu/questionnaires" {
+		t.Error("wrong path", path)
+	}
+}
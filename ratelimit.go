@@ -0,0 +1,135 @@
+package sumsub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// WithRateLimit option limits requests of the client to rps requests per
+// second with bursts of at most burst requests. Requests over the limit wait
+// for their turn, waiting is aborted when context of the request is done, e.g.
+// context passed to Do or GetApplicantStatuses
+func WithRateLimit(rps float64, burst int) Option {
+	return func(s *SumSub) {
+		if rps <= 0 {
+			s.limiter = nil
+			return
+		}
+		if burst < 1 {
+			burst = 1
+		}
+
+		s.limiter = &rateLimiter{
+			rate:   rps,
+			burst:  float64(burst),
+			tokens: float64(burst),
+			last:   time.Now(),
+		}
+	}
+}
+
+// rateLimiter is token bucket, tokens are added with rate per second up to
+// burst, negative count of tokens means requests waiting for their turn
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// wait takes token from the bucket, it blocks until the token is available or
+// ctx is done and returns the delay, nil limiter does not limit requests
+func (l *rateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	if l == nil {
+		return 0, nil
+	}
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	if err := sleep(ctx, delay); err != nil {
+		l.cancel()
+		return delay, err
+	}
+
+	return delay, nil
+}
+
+// reserve takes token and returns delay until the token becomes available
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns token reserved by the request which was not sent
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+}
+
+// WithRetry option retries requests rejected by the api with 429 Too Many
+// Requests status up to max times. Delay is taken from Retry-After header,
+// otherwise it is one second doubled for each next attempt. Requests with body
+// read from io.Reader, e.g. document uploads, are not retried because the body
+// can't be sent again
+func WithRetry(max int) Option {
+	return func(s *SumSub) {
+		s.retries = max
+	}
+}
+
+// replayable returns false if request options v contain body reader
+func replayable(v []interface{}) bool {
+	for _, opt := range v {
+		if _, ok := opt.(io.Reader); ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// retryDelay returns delay before the next attempt of the request
+func retryDelay(r *http.Response, attempt int) time.Duration {
+	if sec, err := strconv.Atoi(r.Header.Get("Retry-After")); err == nil && sec >= 0 {
+		return time.Duration(sec) * time.Second
+	}
+
+	return time.Second << uint(attempt)
+}
+
+// sleep for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,34 @@
+package sumsub
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	s := &SumSub{}
+	WithRateLimit(100, 2)(s)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, err := s.limiter.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(start); d < 15*time.Millisecond {
+		t.Error("requests over burst are not delayed", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.limiter.reserve()
+	if _, err := s.limiter.wait(ctx); err != context.Canceled {
+		t.Error("expected context error, got", err)
+	}
+
+	var unlimited *rateLimiter
+	if _, err := unlimited.wait(ctx); err != nil {
+		t.Error("nil limiter should not limit requests", err)
+	}
+}
@@ -0,0 +1,202 @@
+package sumsub
+
+import "strings"
+
+// reject labels of the review result
+const (
+	RejectLabelAdditionalDocumentRequired         = "ADDITIONAL_DOCUMENT_REQUIRED"
+	RejectLabelAdverseMedia                       = "ADVERSE_MEDIA"
+	RejectLabelAgeRequirementMismatch             = "AGE_REQUIREMENT_MISMATCH"
+	RejectLabelApplicantInterruptedInterview      = "APPLICANT_INTERRUPTED_INTERVIEW"
+	RejectLabelBackSideMissing                    = "BACK_SIDE_MISSING"
+	RejectLabelBadFaceMatching                    = "BAD_FACE_MATCHING"
+	RejectLabelBadProofOfAddress                  = "BAD_PROOF_OF_ADDRESS"
+	RejectLabelBadProofOfIdentity                 = "BAD_PROOF_OF_IDENTITY"
+	RejectLabelBadProofOfPayment                  = "BAD_PROOF_OF_PAYMENT"
+	RejectLabelBadSelfie                          = "BAD_SELFIE"
+	RejectLabelBadVideoSelfie                     = "BAD_VIDEO_SELFIE"
+	RejectLabelBlacklist                          = "BLACKLIST"
+	RejectLabelBlackAndWhite                      = "BLACK_AND_WHITE"
+	RejectLabelBlocklist                          = "BLOCKLIST"
+	RejectLabelCheckUnavailable                   = "CHECK_UNAVAILABLE"
+	RejectLabelCompanyNotDefinedBeneficiaries     = "COMPANY_NOT_DEFINED_BENEFICIARIES"
+	RejectLabelCompanyNotDefinedRepresentatives   = "COMPANY_NOT_DEFINED_REPRESENTATIVES"
+	RejectLabelCompanyNotDefinedStructure         = "COMPANY_NOT_DEFINED_STRUCTURE"
+	RejectLabelCompanyNotValidatedBeneficiaries   = "COMPANY_NOT_VALIDATED_BENEFICIARIES"
+	RejectLabelCompanyNotValidatedRepresentatives = "COMPANY_NOT_VALIDATED_REPRESENTATIVES"
+	RejectLabelCompromisedPersons                 = "COMPROMISED_PERSONS"
+	RejectLabelConnectionInterrupted              = "CONNECTION_INTERRUPTED"
+	RejectLabelCriminal                           = "CRIMINAL"
+	RejectLabelDbDataMismatch                     = "DB_DATA_MISMATCH"
+	RejectLabelDbDataNotFound                     = "DB_DATA_NOT_FOUND"
+	RejectLabelDigitalDocument                    = "DIGITAL_DOCUMENT"
+	RejectLabelDocumentDamaged                    = "DOCUMENT_DAMAGED"
+	RejectLabelDocumentMissing                    = "DOCUMENT_MISSING"
+	RejectLabelDocumentPageMissing                = "DOCUMENT_PAGE_MISSING"
+	RejectLabelDocumentTemplate                   = "DOCUMENT_TEMPLATE"
+	RejectLabelDuplicate                          = "DUPLICATE"
+	RejectLabelExperienceRequirementMismatch      = "EXPERIENCE_REQUIREMENT_MISMATCH"
+	RejectLabelExpirationDate                     = "EXPIRATION_DATE"
+	RejectLabelForgery                            = "FORGERY"
+	RejectLabelFraudulentLiveness                 = "FRAUDULENT_LIVENESS"
+	RejectLabelFraudulentPatterns                 = "FRAUDULENT_PATTERNS"
+	RejectLabelFrontSideMissing                   = "FRONT_SIDE_MISSING"
+	RejectLabelGraphicEditor                      = "GRAPHIC_EDITOR"
+	RejectLabelIdInvalid                          = "ID_INVALID"
+	RejectLabelIncompatibleLanguage               = "INCOMPATIBLE_LANGUAGE"
+	RejectLabelIncompleteDocument                 = "INCOMPLETE_DOCUMENT"
+	RejectLabelInconsistentProfile                = "INCONSISTENT_PROFILE"
+	RejectLabelIncorrectSocialNumber              = "INCORRECT_SOCIAL_NUMBER"
+	RejectLabelLowQuality                         = "LOW_QUALITY"
+	RejectLabelNotAllChecksCompleted              = "NOT_ALL_CHECKS_COMPLETED"
+	RejectLabelNotDocument                        = "NOT_DOCUMENT"
+	RejectLabelPep                                = "PEP"
+	RejectLabelProblematicApplicantData           = "PROBLEMATIC_APPLICANT_DATA"
+	RejectLabelRegulationsViolations              = "REGULATIONS_VIOLATIONS"
+	RejectLabelRequestedDataMismatch              = "REQUESTED_DATA_MISMATCH"
+	RejectLabelSanctions                          = "SANCTIONS"
+	RejectLabelScreenshots                        = "SCREENSHOTS"
+	RejectLabelSelfieMismatch                     = "SELFIE_MISMATCH"
+	RejectLabelSelfieWithPaper                    = "SELFIE_WITH_PAPER"
+	RejectLabelSpam                               = "SPAM"
+	RejectLabelThirdPartyInvolved                 = "THIRD_PARTY_INVOLVED"
+	RejectLabelUnfilledId                         = "UNFILLED_ID"
+	RejectLabelUnsatisfactoryPhotos               = "UNSATISFACTORY_PHOTOS"
+	RejectLabelUnsuitableDocument                 = "UNSUITABLE_DOCUMENT"
+	RejectLabelUnsuitableEnv                      = "UNSUITABLE_ENV"
+	RejectLabelWrongAddress                       = "WRONG_ADDRESS"
+	RejectLabelWrongUserRegion                    = "WRONG_USER_REGION"
+)
+
+// RejectLabelInfo describes reject label, Type is reject type the label is
+// typically returned with, ReviewRejectTypeRETRY or ReviewRejectTypeFINAL
+type RejectLabelInfo struct {
+	Label       string
+	Description string
+	Type        string
+}
+
+// rejectLabels is catalog of the known reject labels
+var rejectLabels = map[string]RejectLabelInfo{
+	RejectLabelAdditionalDocumentRequired:         {RejectLabelAdditionalDocumentRequired, "Additional document is required", ReviewRejectTypeRETRY},
+	RejectLabelApplicantInterruptedInterview:      {RejectLabelApplicantInterruptedInterview, "Video interview was interrupted", ReviewRejectTypeRETRY},
+	RejectLabelBackSideMissing:                    {RejectLabelBackSideMissing, "Back side of the document is missing", ReviewRejectTypeRETRY},
+	RejectLabelBadFaceMatching:                    {RejectLabelBadFaceMatching, "Face on the selfie does not clearly match the document", ReviewRejectTypeRETRY},
+	RejectLabelBadProofOfAddress:                  {RejectLabelBadProofOfAddress, "Proof of address is not acceptable", ReviewRejectTypeRETRY},
+	RejectLabelBadProofOfIdentity:                 {RejectLabelBadProofOfIdentity, "Proof of identity is not acceptable", ReviewRejectTypeRETRY},
+	RejectLabelBadProofOfPayment:                  {RejectLabelBadProofOfPayment, "Proof of payment is not acceptable", ReviewRejectTypeRETRY},
+	RejectLabelBadSelfie:                          {RejectLabelBadSelfie, "Selfie is of poor quality or does not meet requirements", ReviewRejectTypeRETRY},
+	RejectLabelBadVideoSelfie:                     {RejectLabelBadVideoSelfie, "Video selfie is of poor quality or does not meet requirements", ReviewRejectTypeRETRY},
+	RejectLabelBlackAndWhite:                      {RejectLabelBlackAndWhite, "Black and white image is provided, colour image is required", ReviewRejectTypeRETRY},
+	RejectLabelCompanyNotDefinedBeneficiaries:     {RejectLabelCompanyNotDefinedBeneficiaries, "Company beneficiaries are not defined", ReviewRejectTypeRETRY},
+	RejectLabelCompanyNotDefinedRepresentatives:   {RejectLabelCompanyNotDefinedRepresentatives, "Company representatives are not defined", ReviewRejectTypeRETRY},
+	RejectLabelCompanyNotDefinedStructure:         {RejectLabelCompanyNotDefinedStructure, "Company ownership structure is not defined", ReviewRejectTypeRETRY},
+	RejectLabelCompanyNotValidatedBeneficiaries:   {RejectLabelCompanyNotValidatedBeneficiaries, "Company beneficiaries are not verified", ReviewRejectTypeRETRY},
+	RejectLabelCompanyNotValidatedRepresentatives: {RejectLabelCompanyNotValidatedRepresentatives, "Company representatives are not verified", ReviewRejectTypeRETRY},
+	RejectLabelConnectionInterrupted:              {RejectLabelConnectionInterrupted, "Connection was interrupted during verification", ReviewRejectTypeRETRY},
+	RejectLabelDigitalDocument:                    {RejectLabelDigitalDocument, "Digital version of the document is provided, original is required", ReviewRejectTypeRETRY},
+	RejectLabelDocumentDamaged:                    {RejectLabelDocumentDamaged, "Document is damaged", ReviewRejectTypeRETRY},
+	RejectLabelDocumentMissing:                    {RejectLabelDocumentMissing, "Required document is missing", ReviewRejectTypeRETRY},
+	RejectLabelDocumentPageMissing:                {RejectLabelDocumentPageMissing, "Some pages of the document are missing", ReviewRejectTypeRETRY},
+	RejectLabelExpirationDate:                     {RejectLabelExpirationDate, "Document is expired or expires soon", ReviewRejectTypeRETRY},
+	RejectLabelFrontSideMissing:                   {RejectLabelFrontSideMissing, "Front side of the document is missing", ReviewRejectTypeRETRY},
+	RejectLabelGraphicEditor:                      {RejectLabelGraphicEditor, "Image is edited with graphic editor", ReviewRejectTypeRETRY},
+	RejectLabelIdInvalid:                          {RejectLabelIdInvalid, "Document is not valid for identification", ReviewRejectTypeRETRY},
+	RejectLabelIncompatibleLanguage:               {RejectLabelIncompatibleLanguage, "Document language is not supported, translation is required", ReviewRejectTypeRETRY},
+	RejectLabelIncompleteDocument:                 {RejectLabelIncompleteDocument, "Document is incomplete or partially covered", ReviewRejectTypeRETRY},
+	RejectLabelIncorrectSocialNumber:              {RejectLabelIncorrectSocialNumber, "Social security or tax number is incorrect", ReviewRejectTypeRETRY},
+	RejectLabelLowQuality:                         {RejectLabelLowQuality, "Image quality is too low to read the document", ReviewRejectTypeRETRY},
+	RejectLabelNotAllChecksCompleted:              {RejectLabelNotAllChecksCompleted, "Not all verification steps are completed", ReviewRejectTypeRETRY},
+	RejectLabelProblematicApplicantData:           {RejectLabelProblematicApplicantData, "Provided personal data does not match the documents", ReviewRejectTypeRETRY},
+	RejectLabelRequestedDataMismatch:              {RejectLabelRequestedDataMismatch, "Provided data does not match the document", ReviewRejectTypeRETRY},
+	RejectLabelScreenshots:                        {RejectLabelScreenshots, "Screenshot is provided, photo of the original document is required", ReviewRejectTypeRETRY},
+	RejectLabelSelfieMismatch:                     {RejectLabelSelfieMismatch, "Selfie does not match the document photo", ReviewRejectTypeRETRY},
+	RejectLabelUnfilledId:                         {RejectLabelUnfilledId, "Document is not filled in or has no photo", ReviewRejectTypeRETRY},
+	RejectLabelUnsatisfactoryPhotos:               {RejectLabelUnsatisfactoryPhotos, "Photos are unreadable or of poor quality", ReviewRejectTypeRETRY},
+	RejectLabelUnsuitableDocument:                 {RejectLabelUnsuitableDocument, "Document type is not accepted", ReviewRejectTypeRETRY},
+	RejectLabelUnsuitableEnv:                      {RejectLabelUnsuitableEnv, "Verification environment is unsuitable, e.g. other persons are present", ReviewRejectTypeRETRY},
+	RejectLabelWrongAddress:                       {RejectLabelWrongAddress, "Address does not match the provided documents", ReviewRejectTypeRETRY},
+
+	RejectLabelAdverseMedia:                  {RejectLabelAdverseMedia, "Applicant is found in adverse media", ReviewRejectTypeFINAL},
+	RejectLabelAgeRequirementMismatch:        {RejectLabelAgeRequirementMismatch, "Applicant does not meet age requirement", ReviewRejectTypeFINAL},
+	RejectLabelBlacklist:                     {RejectLabelBlacklist, "Applicant is in the blocklist", ReviewRejectTypeFINAL},
+	RejectLabelBlocklist:                     {RejectLabelBlocklist, "Applicant is in the blocklist", ReviewRejectTypeFINAL},
+	RejectLabelCheckUnavailable:              {RejectLabelCheckUnavailable, "Database check is unavailable", ReviewRejectTypeFINAL},
+	RejectLabelCompromisedPersons:            {RejectLabelCompromisedPersons, "Applicant is associated with compromised persons", ReviewRejectTypeFINAL},
+	RejectLabelCriminal:                      {RejectLabelCriminal, "Applicant is involved in criminal activity", ReviewRejectTypeFINAL},
+	RejectLabelDbDataMismatch:                {RejectLabelDbDataMismatch, "Data does not match the official database", ReviewRejectTypeFINAL},
+	RejectLabelDbDataNotFound:                {RejectLabelDbDataNotFound, "Data is not found in the official database", ReviewRejectTypeFINAL},
+	RejectLabelDocumentTemplate:              {RejectLabelDocumentTemplate, "Document template is provided instead of real document", ReviewRejectTypeFINAL},
+	RejectLabelDuplicate:                     {RejectLabelDuplicate, "Applicant is a duplicate of another applicant", ReviewRejectTypeFINAL},
+	RejectLabelExperienceRequirementMismatch: {RejectLabelExperienceRequirementMismatch, "Applicant does not meet experience requirement", ReviewRejectTypeFINAL},
+	RejectLabelForgery:                       {RejectLabelForgery, "Document is forged", ReviewRejectTypeFINAL},
+	RejectLabelFraudulentLiveness:            {RejectLabelFraudulentLiveness, "Liveness check detected fraud attempt", ReviewRejectTypeFINAL},
+	RejectLabelFraudulentPatterns:            {RejectLabelFraudulentPatterns, "Fraudulent behaviour is detected", ReviewRejectTypeFINAL},
+	RejectLabelInconsistentProfile:           {RejectLabelInconsistentProfile, "Data or documents of different persons are provided", ReviewRejectTypeFINAL},
+	RejectLabelNotDocument:                   {RejectLabelNotDocument, "Provided file is not a document", ReviewRejectTypeFINAL},
+	RejectLabelPep:                           {RejectLabelPep, "Applicant is politically exposed person", ReviewRejectTypeFINAL},
+	RejectLabelRegulationsViolations:         {RejectLabelRegulationsViolations, "Verification violates regulations", ReviewRejectTypeFINAL},
+	RejectLabelSanctions:                     {RejectLabelSanctions, "Applicant is found in sanctions lists", ReviewRejectTypeFINAL},
+	RejectLabelSelfieWithPaper:               {RejectLabelSelfieWithPaper, "Selfie with paper is provided instead of live selfie", ReviewRejectTypeFINAL},
+	RejectLabelSpam:                          {RejectLabelSpam, "Too many files or attempts are submitted", ReviewRejectTypeFINAL},
+	RejectLabelThirdPartyInvolved:            {RejectLabelThirdPartyInvolved, "Third party is involved in the verification", ReviewRejectTypeFINAL},
+	RejectLabelWrongUserRegion:               {RejectLabelWrongUserRegion, "Applicant region is not supported", ReviewRejectTypeFINAL},
+}
+
+// LookupRejectLabel returns description of the reject label
+func LookupRejectLabel(label string) (RejectLabelInfo, bool) {
+	info, ok := rejectLabels[label]
+	return info, ok
+}
+
+// Guidance returns user-facing text describing why review is rejected and
+// what applicant should do, empty string is returned for approved review
+func (result ReviewResult) Guidance() string {
+	if result.ReviewAnswer != ReviewResultRED {
+		return ""
+	}
+
+	var b strings.Builder
+	if result.IsRetryable() {
+		b.WriteString("Please resubmit your documents.")
+	} else {
+		b.WriteString("Unfortunately, your verification is declined.")
+	}
+
+	for _, label := range result.RejectLabels {
+		if info, ok := rejectLabels[label]; ok {
+			b.WriteString(" " + info.Description + ".")
+		}
+	}
+
+	return b.String()
+}
+
+// IsFinalReject reports whether applicant is rejected without possibility to
+// resubmit, it is true for FINAL and EXTERNAL reject types and for RED answer
+// without reject type
+func (result ReviewResult) IsFinalReject() bool {
+	return result.ReviewAnswer == ReviewResultRED && result.ReviewRejectType != ReviewRejectTypeRETRY
+}
+
+// IsRetryable reports whether applicant is rejected with possibility to fix
+// the issues and pass review again
+func (result ReviewResult) IsRetryable() bool {
+	return result.ReviewAnswer == ReviewResultRED && result.ReviewRejectType == ReviewRejectTypeRETRY
+}
+
+// NeedsResubmission reports whether applicant should upload documents again,
+// it is false for retryable review if all reject labels are known to be final
+func (result ReviewResult) NeedsResubmission() bool {
+	if !result.IsRetryable() {
+		return false
+	}
+
+	for _, label := range result.RejectLabels {
+		if info, ok := rejectLabels[label]; !ok || info.Type == ReviewRejectTypeRETRY {
+			return true
+		}
+	}
+
+	return len(result.RejectLabels) == 0
+}
@@ -0,0 +1,46 @@
+package sumsub
+
+import "testing"
+
+func TestRejectLabels(t *testing.T) {
+	info, ok := LookupRejectLabel(RejectLabelForgery)
+	if !ok || info.Type != ReviewRejectTypeFINAL {
+		t.Errorf("wrong label info %+v", info)
+	}
+
+	result := ReviewResult{
+		ReviewAnswer:     ReviewResultRED,
+		ReviewRejectType: ReviewRejectTypeRETRY,
+		RejectLabels:     []string{RejectLabelBadSelfie, "UNKNOWN_LABEL"},
+	}
+	expected := "Please resubmit your documents. Selfie is of poor quality or does not meet requirements."
+	if guidance := result.Guidance(); guidance != expected {
+		t.Error("wrong guidance:", guidance)
+	}
+
+	if guidance := (ReviewResult{ReviewAnswer: ReviewResultGREEN}).Guidance(); guidance != "" {
+		t.Error("unexpected guidance for approved review:", guidance)
+	}
+}
+
+func TestReviewResultRejectType(t *testing.T) {
+	tests := []struct {
+		result                         ReviewResult
+		final, retryable, resubmission bool
+	}{
+		{ReviewResult{ReviewAnswer: ReviewResultGREEN}, false, false, false},
+		{ReviewResult{ReviewAnswer: ReviewResultRED, ReviewRejectType: ReviewRejectTypeFINAL}, true, false, false},
+		{ReviewResult{ReviewAnswer: ReviewResultRED, ReviewRejectType: ReviewRejectTypeEXTERNAL}, true, false, false},
+		{ReviewResult{ReviewAnswer: ReviewResultRED}, true, false, false},
+		{ReviewResult{ReviewAnswer: ReviewResultRED, ReviewRejectType: ReviewRejectTypeRETRY}, false, true, true},
+		{ReviewResult{ReviewAnswer: ReviewResultRED, ReviewRejectType: ReviewRejectTypeRETRY, RejectLabels: []string{RejectLabelBadSelfie}}, false, true, true},
+		{ReviewResult{ReviewAnswer: ReviewResultRED, ReviewRejectType: ReviewRejectTypeRETRY, RejectLabels: []string{RejectLabelForgery}}, false, true, false},
+	}
+
+	for i, test := range tests {
+		r := test.result
+		if r.IsFinalReject() != test.final || r.IsRetryable() != test.retryable || r.NeedsResubmission() != test.resubmission {
+			t.Errorf("%d: unexpected result %t %t %t", i, r.IsFinalReject(), r.IsRetryable(), r.NeedsResubmission())
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package sumsub
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// reportPageSize is count of applicants requested per page to build report
+const reportPageSize = 100
+
+// ReviewReport is summary of review outcomes of applicants created in the
+// date range
+type ReviewReport struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	Total     int `json:"total"`
+	Completed int `json:"completed"`
+	Pending   int `json:"pending"`
+	Green     int `json:"green"`
+	Red       int `json:"red"`
+
+	RejectTypes  map[string]int `json:"rejectTypes"`
+	RejectLabels map[string]int `json:"rejectLabels"`
+}
+
+// NewReviewReport prepare empty report for the date range [from, to)
+func NewReviewReport(from, to time.Time) *ReviewReport {
+	return &ReviewReport{
+		From:         from,
+		To:           to,
+		RejectTypes:  make(map[string]int),
+		RejectLabels: make(map[string]int),
+	}
+}
+
+// ReviewReport walks through all applicants and aggregates review outcomes
+// of those created in the date range [from, to)
+func (s *SumSub) ReviewReport(from, to time.Time) (*ReviewReport, error) {
+	r := NewReviewReport(from, to)
+
+	for offset := 0; ; offset += reportPageSize {
+		items, total, err := s.ListApplicants(offset, reportPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range items {
+			r.Add(a)
+		}
+
+		if len(items) == 0 || offset+len(items) >= total {
+			break
+		}
+	}
+
+	return r, nil
+}
+
+// Add applicant to the report, applicants created outside of the date range
+// are ignored
+func (r *ReviewReport) Add(a Applicant) {
+	created := a.CreatedAt.Time
+	if created.IsZero() || created.Before(r.From) || !created.Before(r.To) {
+		return
+	}
+
+	r.Total++
+
+	status := ApplicantStatus{ReviewStatus: a.Review.ReviewStatus}
+	if !status.IsCompleted() {
+		r.Pending++
+		return
+	}
+	r.Completed++
+
+	result := a.Review.ReviewResult
+	switch result.ReviewAnswer {
+	case ReviewResultGREEN:
+		r.Green++
+	case ReviewResultRED:
+		r.Red++
+		if result.ReviewRejectType != "" {
+			r.RejectTypes[result.ReviewRejectType]++
+		}
+		for _, label := range result.RejectLabels {
+			r.RejectLabels[label]++
+		}
+	}
+}
+
+// WriteJSON encodes report as json object
+func (r *ReviewReport) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(r)
+}
+
+// WriteCSV encodes report as csv table with `group,name,count` columns
+func (r *ReviewReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	records := [][]string{
+		{"group", "name", "count"},
+		{"review", "total", strconv.Itoa(r.Total)},
+		{"review", "completed", strconv.Itoa(r.Completed)},
+		{"review", "pending", strconv.Itoa(r.Pending)},
+		{"review", "green", strconv.Itoa(r.Green)},
+		{"review", "red", strconv.Itoa(r.Red)},
+	}
+	records = append(records, countRecords("rejectType", r.RejectTypes)...)
+	records = append(records, countRecords("rejectLabel", r.RejectLabels)...)
+
+	if err := cw.WriteAll(records); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// countRecords converts counters to csv records sorted by name
+func countRecords(group string, counts map[string]int) [][]string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	records := make([][]string, len(names))
+	for i, name := range names {
+		records[i] = []string{group, name, strconv.Itoa(counts[name])}
+	}
+
+	return records
+}
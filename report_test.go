@@ -0,0 +1,49 @@
+package sumsub
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReviewReport(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewReviewReport(from, from.AddDate(0, 0, 7))
+
+	applicant := func(created, status string, result ReviewResult) Applicant {
+		var a Applicant
+		a.CreatedAt, _ = ParseTime(created)
+		a.Review.ReviewStatus = status
+		a.Review.ReviewResult = result
+		return a
+	}
+
+	r.Add(applicant("2020-01-02 10:00:00", ReviewStatusCompleted, ReviewResult{
+		ReviewAnswer: ReviewResultGREEN,
+	}))
+	r.Add(applicant("2020-01-03 10:00:00", ReviewStatusCompleted, ReviewResult{
+		ReviewAnswer:     ReviewResultRED,
+		ReviewRejectType: "RETRY",
+		RejectLabels:     []string{"BAD_SELFIE", "UNSATISFACTORY_PHOTOS"},
+	}))
+	r.Add(applicant("2020-01-04 10:00:00", ReviewStatusPending, ReviewResult{}))
+	r.Add(applicant("2020-02-01 10:00:00", ReviewStatusCompleted, ReviewResult{}))
+
+	if r.Total != 3 || r.Completed != 2 || r.Pending != 1 || r.Green != 1 || r.Red != 1 {
+		t.Errorf("wrong counters %+v", r)
+	}
+
+	if r.RejectLabels["BAD_SELFIE"] != 1 || r.RejectTypes["RETRY"] != 1 {
+		t.Errorf("wrong reject counters %+v", r)
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteCSV(&buf); err != nil {
+		t.Error(err)
+	}
+
+	if !strings.Contains(buf.String(), "rejectLabel,BAD_SELFIE,1\n") {
+		t.Error("reject label not found in csv", buf.String())
+	}
+}
@@ -0,0 +1,93 @@
+package sumsub
+
+import (
+	"github.com/imroc/req"
+)
+
+// ReviewAttempt is one of the historical reviews of the applicant
+type ReviewAttempt struct {
+	ID         string `json:"id"`
+	AttemptID  string `json:"attemptId"`
+	AttemptCnt int    `json:"attemptCnt"`
+	LevelName  string `json:"levelName,omitempty"`
+
+	CreateDate Time `json:"createDate"`
+	ReviewDate Time `json:"reviewDate,omitempty"`
+
+	ReviewStatus string       `json:"reviewStatus"`
+	ReviewResult ReviewResult `json:"reviewResult"`
+
+	// ReviewerType is `auto` for automatic checks or `human` for moderators
+	ReviewerType string `json:"reviewerType,omitempty"`
+}
+
+// GetApplicantReviewHistory returns all reviews of the applicant, e.g. two
+// rejected attempts and final approval
+// GET /resources/applicants/{applicantId}/status/history
+func (s *SumSub) GetApplicantReviewHistory(id string) ([]ReviewAttempt, error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/status/history")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var history struct {
+		Items []ReviewAttempt `json:"items"`
+	}
+	err = s.decode(resp, &history)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return history.Items, err
+}
+
+// ModerationState is outcome of the single check of the applicant, e.g. face
+// match, document validity or AML screening
+type ModerationState struct {
+	ID           string `json:"id"`
+	ApplicantID  string `json:"applicantId"`
+	InspectionID string `json:"inspectionId"`
+	CreatedAt    Time   `json:"createdAt"`
+
+	CheckType    string   `json:"checkType"`
+	Answer       string   `json:"answer"`
+	RejectLabels []string `json:"rejectLabels,omitempty"`
+	Comment      string   `json:"comment,omitempty"`
+	ImageIDs     []string `json:"imageIds,omitempty"`
+}
+
+// IsRed returns true if the check is failed
+func (state ModerationState) IsRed() bool {
+	return state.Answer == ReviewResultRED
+}
+
+// GetModerationStates returns per-check moderation outcomes of the applicant
+// GET /resources/moderationStates/-;applicantId={applicantId}
+func (s *SumSub) GetModerationStates(id string) ([]ModerationState, error) {
+	resp, err := s.do("GET", "resources/moderationStates/-;applicantId="+id)
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	var states struct {
+		List struct {
+			Items []ModerationState `json:"items"`
+		} `json:"list"`
+	}
+	err = s.decode(resp, &states)
+	if err != nil && !isSchemaError(err) {
+		return nil, err
+	}
+
+	return states.List.Items, err
+}
+
+// SetApplicantPriority changes review priority of the applicant, applicants
+// with higher priority are reviewed first
+// PATCH /resources/applicants/{applicantId}/review/priority
+func (s *SumSub) SetApplicantPriority(id string, priority int) error {
+	body := map[string]int{"priority": priority}
+
+	resp, err := s.do("PATCH", "resources/applicants/"+id+"/review/priority", req.BodyJSON(body))
+	return handleResponse(resp, err)
+}
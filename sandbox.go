@@ -0,0 +1,86 @@
+package sumsub
+
+import (
+	"sort"
+
+	"github.com/imroc/req"
+)
+
+// SimulateApproval completes review of the applicant with GREEN answer, it
+// works only in the test environment
+func (s *SumSub) SimulateApproval(id string) error {
+	return s.ApplicantComplete(id, ApplicantCompleteRequest{
+		ReviewAnswer: ReviewResultGREEN,
+	})
+}
+
+// SimulateFinalRejection completes review of the applicant with RED answer
+// without possibility to resubmit documents
+func (s *SumSub) SimulateFinalRejection(id, comment string, labels ...string) error {
+	return s.ApplicantComplete(id, ApplicantCompleteRequest{
+		ReviewAnswer:      ReviewResultRED,
+		ReviewRejectType:  ReviewRejectTypeFINAL,
+		RejectLabels:      labels,
+		ModerationComment: comment,
+	})
+}
+
+// SimulateRetry completes review of the applicant with RED answer, the
+// applicant is asked to resubmit documents
+func (s *SumSub) SimulateRetry(id, comment string, labels ...string) error {
+	return s.ApplicantComplete(id, ApplicantCompleteRequest{
+		ReviewAnswer:      ReviewResultRED,
+		ReviewRejectType:  ReviewRejectTypeRETRY,
+		RejectLabels:      labels,
+		ModerationComment: comment,
+	})
+}
+
+// SimulateImageReviews completes review of the applicant with outcomes of the
+// separate images by image id, review answer is RED with RETRY reject type if
+// any of the images is rejected
+func (s *SumSub) SimulateImageReviews(id string, images map[string]ImageReviewResult) error {
+	data := ApplicantCompleteRequest{
+		ReviewAnswer:       ReviewResultGREEN,
+		ImageReviewResults: images,
+	}
+
+	for _, image := range images {
+		if image.ReviewAnswer != ReviewResultRED {
+			continue
+		}
+
+		data.ReviewAnswer = ReviewResultRED
+		data.ReviewRejectType = ReviewRejectTypeRETRY
+		for _, label := range image.RejectLabels {
+			if !containsString(data.RejectLabels, label) {
+				data.RejectLabels = append(data.RejectLabels, label)
+			}
+		}
+	}
+	sort.Strings(data.RejectLabels)
+
+	return s.ApplicantComplete(id, data)
+}
+
+// TestWebhookRequest is test webhook parameters, webhook is sent to the
+// endpoints configured in the dashboard
+type TestWebhookRequest struct {
+	// Type is webhook type, e.g. WebhookApplicantReviewed
+	Type string `json:"type"`
+
+	// ApplicantID of the existing applicant payload is built for, random data
+	// is used if empty
+	ApplicantID string `json:"applicantId,omitempty"`
+
+	// ReviewAnswer of the applicantReviewed webhook, GREEN or RED
+	ReviewAnswer string `json:"reviewAnswer,omitempty"`
+}
+
+// SendTestWebhook asks sumsub to deliver webhook of the chosen type, it works
+// only in the test environment and allows to smoke test webhook consumer
+// POST /resources/webhooks/test
+func (s *SumSub) SendTestWebhook(data TestWebhookRequest) error {
+	resp, err := s.do("POST", "resources/webhooks/test", req.BodyJSON(data))
+	return handleResponse(resp, err)
+}
@@ -0,0 +1,133 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/imroc/req"
+)
+
+// WebSDKLink is shareable link to the verification page
+type WebSDKLink struct {
+	URL string `json:"url"`
+}
+
+// WebSDKLinkOptions are parameters of the verification link
+type WebSDKLinkOptions struct {
+	// UserID is external user id of the applicant
+	UserID string
+
+	// TTL is link lifetime, zero means default lifetime
+	TTL time.Duration
+
+	// Lang is locale of the verification page, e.g. en or de
+	Lang string
+}
+
+// GenerateWebSDKLink for the level, link can be sent to the user to complete
+// verification outside of the application
+// POST /resources/sdkIntegrations/levels/{levelName}/websdkLink?externalUserId=&ttlInSecs=&lang=
+func (s *SumSub) GenerateWebSDKLink(levelName string, opts WebSDKLinkOptions) (link WebSDKLink, err error) {
+	query := req.QueryParam{"externalUserId": opts.UserID}
+	if opts.TTL > 0 {
+		query["ttlInSecs"] = int(opts.TTL.Seconds())
+	}
+	if opts.Lang != "" {
+		query["lang"] = opts.Lang
+	}
+
+	resp, err := s.do("POST", "resources/sdkIntegrations/levels/"+url.PathEscape(levelName)+"/websdkLink", query)
+	if err := handleResponse(resp, err); err != nil {
+		return link, err
+	}
+
+	err = s.decode(resp, &link)
+	return
+}
+
+// RequiredDocStatus is status of the required document set, it is nil if
+// documents are not uploaded yet
+type RequiredDocStatus struct {
+	Country      string        `json:"country,omitempty"`
+	IDDocType    string        `json:"idDocType,omitempty"`
+	ImageIDs     []json.Number `json:"imageIds,omitempty"`
+	ReviewResult *ReviewResult `json:"reviewResult,omitempty"`
+}
+
+// GetRequiredDocsStatus returns statuses of the required document sets by
+// document set type
+// GET /resources/applicants/{applicantId}/requiredIdDocsStatus
+func (s *SumSub) GetRequiredDocsStatus(id string) (statuses map[string]*RequiredDocStatus, err error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/requiredIdDocsStatus")
+	if err := handleResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	err = s.decode(resp, &statuses)
+	return
+}
+
+// OnboardingStep is required document set of the applicant level
+type OnboardingStep struct {
+	IDDocSetType string
+	Uploaded     bool
+
+	// ReviewAnswer is GREEN or RED if document set is already reviewed
+	ReviewAnswer string
+}
+
+// OnboardingState is progress of the applicant in the SDK, steps are in order
+// of the level document sets
+type OnboardingState struct {
+	Steps []OnboardingStep
+}
+
+// CurrentStep returns document set type the applicant should upload next,
+// empty string means all documents are uploaded
+func (state OnboardingState) CurrentStep() string {
+	for _, step := range state.Steps {
+		if !step.Uploaded {
+			return step.IDDocSetType
+		}
+	}
+
+	return ""
+}
+
+// IsDone reports whether all required documents are uploaded
+func (state OnboardingState) IsDone() bool {
+	return state.CurrentStep() == ""
+}
+
+// GetOnboardingState of the applicant built from the level document sets and
+// their statuses
+func (s *SumSub) GetOnboardingState(id string) (state OnboardingState, err error) {
+	a, err := s.GetApplicant(id)
+	if err != nil {
+		return state, err
+	}
+
+	statuses, err := s.GetRequiredDocsStatus(id)
+	if err != nil {
+		return state, err
+	}
+
+	return newOnboardingState(a.RequiredIdDocs.DocSets, statuses), nil
+}
+
+func newOnboardingState(docSets []ApplicantDoc, statuses map[string]*RequiredDocStatus) (state OnboardingState) {
+	for _, docSet := range docSets {
+		step := OnboardingStep{IDDocSetType: docSet.IDDocSetType}
+		if status := statuses[docSet.IDDocSetType]; status != nil {
+			step.Uploaded = true
+			if status.ReviewResult != nil {
+				step.ReviewAnswer = status.ReviewResult.ReviewAnswer
+			}
+		}
+
+		state.Steps = append(state.Steps, step)
+	}
+
+	return state
+}
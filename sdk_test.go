@@ -0,0 +1,37 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOnboardingState(t *testing.T) {
+	docSets := []ApplicantDoc{
+		{IDDocSetType: IDDocSetType_IDENTITY},
+		{IDDocSetType: IDDocSetType_SELFIE},
+		{IDDocSetType: IDDocSetType_PROOF_OF_RESIDENCE},
+	}
+
+	var statuses map[string]*RequiredDocStatus
+	data := `{
+		"IDENTITY": {"country": "GBR", "idDocType": "PASSPORT", "imageIds": [1, 2], "reviewResult": {"reviewAnswer": "GREEN"}},
+		"SELFIE": null
+	}`
+	if err := json.Unmarshal([]byte(data), &statuses); err != nil {
+		t.Fatal(err)
+	}
+
+	state := newOnboardingState(docSets, statuses)
+	if state.CurrentStep() != IDDocSetType_SELFIE || state.IsDone() {
+		t.Error("wrong current step", state.CurrentStep())
+	}
+	if state.Steps[0].ReviewAnswer != ReviewResultGREEN {
+		t.Error("wrong review answer", state.Steps[0])
+	}
+
+	statuses[IDDocSetType_SELFIE] = &RequiredDocStatus{}
+	statuses[IDDocSetType_PROOF_OF_RESIDENCE] = &RequiredDocStatus{}
+	if state := newOnboardingState(docSets, statuses); !state.IsDone() {
+		t.Error("expected done state", state)
+	}
+}
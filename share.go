@@ -0,0 +1,46 @@
+package sumsub
+
+import (
+	"time"
+
+	"github.com/imroc/req"
+)
+
+// ShareToken allows partner client to import verified applicant
+type ShareToken struct {
+	Token       string `json:"token"`
+	ForClientID string `json:"forClientId"`
+}
+
+// GenerateShareToken for the applicant, token can be used only by client
+// forClientID, zero ttl means default lifetime
+// POST /resources/accessTokens/-/shareToken?applicantId=&forClientId=&ttlInSecs=
+func (s *SumSub) GenerateShareToken(applicantID, forClientID string, ttl time.Duration) (token ShareToken, err error) {
+	query := req.QueryParam{
+		"applicantId": applicantID,
+		"forClientId": forClientID,
+	}
+	if ttl > 0 {
+		query["ttlInSecs"] = int(ttl.Seconds())
+	}
+
+	resp, err := s.do("POST", "resources/accessTokens/-/shareToken", query)
+	if err := handleResponse(resp, err); err != nil {
+		return token, err
+	}
+
+	err = s.decode(resp, &token)
+	return
+}
+
+// ImportApplicant shared by another client with share token
+// POST /resources/applicants/-/import?shareToken=
+func (s *SumSub) ImportApplicant(shareToken string) (a Applicant, err error) {
+	resp, err := s.do("POST", "resources/applicants/-/import", req.QueryParam{"shareToken": shareToken})
+	if err := handleResponse(resp, err); err != nil {
+		return a, err
+	}
+
+	err = s.decode(resp, &a)
+	return
+}
@@ -0,0 +1,234 @@
+package sumsub
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/imroc/req"
+)
+
+// WithStrictDecoding option makes client check responses against declared
+// structs, SchemaError is returned if response contains unknown fields or
+// unknown values of the review status, answer, reject type and labels. It
+// is intended for staging to catch changes of the api, response is decoded
+// in spite of the error
+func WithStrictDecoding() Option {
+	return func(s *SumSub) {
+		s.strict = true
+	}
+}
+
+// SchemaError describes response which does not match declared structs
+type SchemaError struct {
+	// Fields are json paths of the unknown fields, e.g. review.newField
+	Fields []string
+
+	// Values are unknown enum values with their json paths
+	Values []string
+}
+
+func (e *SchemaError) Error() string {
+	var issues []string
+	if len(e.Fields) > 0 {
+		issues = append(issues, "unknown fields "+strings.Join(e.Fields, ", "))
+	}
+	if len(e.Values) > 0 {
+		issues = append(issues, "unknown values "+strings.Join(e.Values, ", "))
+	}
+
+	return "response does not match schema: " + strings.Join(issues, "; ")
+}
+
+// enumField is field of the struct with enum value
+type enumField struct {
+	t     reflect.Type
+	field string
+}
+
+var (
+	reviewStatuses = map[string]bool{
+		ReviewStatusInit: true, ReviewStatusPending: true, ReviewStatusQueued: true, ReviewStatusCompleted: true,
+		ReviewStatusCompletedSent: true, ReviewStatusCompletedSetFailure: true, ReviewStatusOnHold: true,
+	}
+	reviewAnswers     = map[string]bool{ReviewResultGREEN: true, ReviewResultRED: true}
+	reviewRejectTypes = map[string]bool{ReviewRejectTypeFINAL: true, ReviewRejectTypeRETRY: true, ReviewRejectTypeEXTERNAL: true}
+)
+
+// enumValues are known values of the enum fields by struct type and field
+// name, fields with the same json name in other structs are not checked
+var enumValues = map[enumField]map[string]bool{
+	{reflect.TypeOf(ApplicantReview{}), "ReviewStatus"}:  reviewStatuses,
+	{reflect.TypeOf(ApplicantStatus{}), "ReviewStatus"}:  reviewStatuses,
+	{reflect.TypeOf(ReviewAttempt{}), "ReviewStatus"}:    reviewStatuses,
+	{reflect.TypeOf(WebhookPayload{}), "ReviewStatus"}:   reviewStatuses,
+	{reflect.TypeOf(ReviewResult{}), "ReviewAnswer"}:     reviewAnswers,
+	{reflect.TypeOf(ReviewResult{}), "ReviewRejectType"}: reviewRejectTypes,
+}
+
+// rejectLabelsField is checked against known reject labels
+var rejectLabelsField = enumField{reflect.TypeOf(ReviewResult{}), "RejectLabels"}
+
+// decode response into v, in strict mode response is checked against type
+// of v. SchemaError is returned after v is filled, so v can be used in
+// spite of the error
+func (s *SumSub) decode(resp *req.Resp, v interface{}) error {
+	if err := resp.ToJSON(v); err != nil || !s.strict {
+		return err
+	}
+
+	data, err := resp.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	if err := checkSchema(data, v); err != nil {
+		log.Warningf("%s %s: %v", resp.Request().Method, resp.Request().URL.Path, err)
+		return err
+	}
+
+	return nil
+}
+
+// isSchemaError reports whether err is returned by strict decoding, decoded
+// value is valid in spite of such error
+func isSchemaError(err error) bool {
+	_, ok := err.(*SchemaError)
+	return ok
+}
+
+// checkSchema compares json data with the type of v
+func checkSchema(data []byte, v interface{}) error {
+	var raw interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&raw); err != nil {
+		return err
+	}
+
+	e := &SchemaError{}
+	e.check("", raw, reflect.TypeOf(v))
+	if len(e.Fields) == 0 && len(e.Values) == 0 {
+		return nil
+	}
+
+	sort.Strings(e.Fields)
+	sort.Strings(e.Values)
+	return e
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// check raw json value against type t, issues are collected with json path
+func (e *SchemaError) check(path string, raw interface{}, t reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == rawMessageType {
+		return
+	}
+
+	switch raw := raw.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for key, value := range raw {
+				field, ok := fields[strings.ToLower(key)]
+				if !ok {
+					e.Fields = append(e.Fields, joinPath(path, key))
+					continue
+				}
+				e.checkValue(joinPath(path, key), enumField{field.owner, field.Name}, value, field.Type)
+			}
+		case reflect.Map:
+			for key, value := range raw {
+				e.check(joinPath(path, key), value, t.Elem())
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, value := range raw {
+				e.check(fmt.Sprintf("%s[%d]", path, i), value, t.Elem())
+			}
+		}
+	}
+}
+
+// checkValue checks value of the struct field, values of the known enum
+// fields are verified
+func (e *SchemaError) checkValue(path string, f enumField, value interface{}, t reflect.Type) {
+	if known, ok := enumValues[f]; ok {
+		if s, ok := value.(string); ok && s != "" && !known[s] {
+			e.Values = append(e.Values, fmt.Sprintf("%s=%s", path, s))
+		}
+	}
+
+	if f == rejectLabelsField {
+		if labels, ok := value.([]interface{}); ok {
+			for i, label := range labels {
+				if s, ok := label.(string); ok {
+					if _, known := rejectLabels[s]; !known {
+						e.Values = append(e.Values, fmt.Sprintf("%s[%d]=%s", path, i, s))
+					}
+				}
+			}
+		}
+	}
+
+	e.check(path, value, t)
+}
+
+// structField is field with the struct type it is declared in
+type structField struct {
+	reflect.StructField
+	owner reflect.Type
+}
+
+// jsonFields returns fields of the struct by lowercased json name, fields of
+// the embedded structs are included
+func jsonFields(t reflect.Type) map[string]structField {
+	fields := make(map[string]structField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for k, v := range jsonFields(ft) {
+				if _, ok := fields[k]; !ok {
+					fields[k] = v
+				}
+			}
+			continue
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = structField{f, t}
+	}
+
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,126 @@
+package sumsub
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCheckSchema(t *testing.T) {
+	var list applicantsList
+	data := []byte(`{"list": {"items": [{
+		"id": "id",
+		"externalUserId": "user-1",
+		"createdAt": "2020-02-21 13:23:19",
+		"info": {"country": "GBR", "addresses": [{"country": "GBR"}]},
+		"review": {"reviewStatus": "completed", "reviewResult": {"reviewAnswer": "RED", "rejectLabels": ["BAD_SELFIE"], "reviewRejectType": "RETRY"}}
+	}], "totalItems": 1}}`)
+	if err := checkSchema(data, &list); err != nil {
+		t.Error(err)
+	}
+
+	data = []byte(`{"list": {"items": [{
+		"id": "id",
+		"riskScore": 10,
+		"info": {"addresses": [{"country": "GBR", "district": "x"}]},
+		"review": {"reviewStatus": "awaitingUser", "reviewResult": {"reviewAnswer": "RED", "rejectLabels": ["NEW_LABEL"]}}
+	}], "totalItems": 1, "page": 1}}`)
+	err := checkSchema(data, &list)
+	e, ok := err.(*SchemaError)
+	if !ok {
+		t.Fatal("expected schema error, got", err)
+	}
+
+	fields := []string{"list.items[0].info.addresses[0].district", "list.items[0].riskScore", "list.page"}
+	if len(e.Fields) != len(fields) {
+		t.Fatal("wrong unknown fields", e.Fields)
+	}
+	for i := range fields {
+		if e.Fields[i] != fields[i] {
+			t.Error("wrong unknown field", e.Fields[i])
+		}
+	}
+
+	values := []string{"list.items[0].review.reviewResult.rejectLabels[0]=NEW_LABEL", "list.items[0].review.reviewStatus=awaitingUser"}
+	if len(e.Values) != len(values) || e.Values[0] != values[0] || e.Values[1] != values[1] {
+		t.Error("wrong unknown values", e.Values)
+	}
+}
+
+func TestCheckSchemaEnumFields(t *testing.T) {
+	var webhook ApplicantReviewedWebhook
+	data := []byte(`{"type": "applicantReviewed", "reviewStatus": "unknownStatus", "reviewResult": {"reviewAnswer": "YELLOW"}}`)
+	err := checkSchema(data, &webhook)
+	if e, ok := err.(*SchemaError); !ok || len(e.Values) != 2 {
+		t.Error("expected unknown values of the embedded and nested structs, got", err)
+	}
+
+	var other struct {
+		ReviewStatus string `json:"reviewStatus"`
+		ReviewAnswer string `json:"reviewAnswer"`
+		RejectLabels []string
+	}
+	data = []byte(`{"reviewStatus": "processing", "reviewAnswer": "YELLOW", "rejectLabels": ["HIGH_RISK"]}`)
+	if err := checkSchema(data, &other); err != nil {
+		t.Error("fields of other structs should not be checked against review enums:", err)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"applicantId": "id", "reviewStatus": "pending", "priority": 1}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+
+	if _, err := s.GetApplicantStatus("id"); err != nil {
+		t.Error("unexpected error in default mode", err)
+	}
+
+	WithStrictDecoding()(s)
+	status, err := s.GetApplicantStatus("id")
+	if _, ok := err.(*SchemaError); !ok {
+		t.Error("expected schema error, got", err)
+	}
+	if status.ReviewStatus != ReviewStatusPending {
+		t.Error("response is not decoded", status.ReviewStatus)
+	}
+}
+
+func TestStrictDecodingList(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"list": {"items": [{"id": "id", "externalUserId": "user-1", "riskScore": 10}], "totalItems": 1}}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+	WithStrictDecoding()(s)
+	WithCache(nil, time.Minute)(s)
+
+	a, err := s.GetApplicant("id")
+	if _, ok := err.(*SchemaError); !ok {
+		t.Error("expected schema error, got", err)
+	}
+	if a.ID != "id" || a.ExternalUserID != "user-1" {
+		t.Errorf("applicant is not decoded %+v", a)
+	}
+
+	if a, err := s.GetApplicant("id"); err != nil || a.ID != "id" || requests != 1 {
+		t.Error("applicant is not cached", a.ID, err, requests)
+	}
+
+	items, total, err := s.ListApplicants(0, 10)
+	if _, ok := err.(*SchemaError); !ok {
+		t.Error("expected schema error, got", err)
+	}
+	if len(items) != 1 || items[0].ID != "id" || total != 1 {
+		t.Errorf("applicants are not decoded %+v %d", items, total)
+	}
+}
@@ -2,14 +2,16 @@ package sumsub
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"net/http"
 	"net/url"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/goware/urlx"
@@ -28,51 +30,279 @@ var (
 
 	// token lifetime is 7 days, recomended renew token earlier
 	tokenLifetime = time.Hour * 150
+
+	// timeout of the api requests
+	requestTimeout = 2 * time.Minute
 )
 
 // SumSub
 type SumSub struct {
-	url  url.URL
-	user string
-	pass string
+	url   url.URL
+	creds CredentialsProvider
+
+	multipart MultipartOptions
+	readOnly  bool
+	strict    bool
+	validate  bool
+
+	docWarnings bool
+	duplicates  DuplicatePolicy
+	uploads     *uploadsRegistry
+
+	observer Observer
+	tracer   Tracer
+
+	cache    Cache
+	cacheTTL time.Duration
+
+	limiter *rateLimiter
+	retries int
+
+	// client sends requests, it is created once by httpClient
+	client     *req.Req
+	clientOnce sync.Once
 
+	mu           sync.Mutex
 	token        string
 	tokenExpired time.Time
 }
 
+// Option configures client
+type Option func(*SumSub)
+
+// ErrReadOnly is returned by mutating methods of the read-only client
+var ErrReadOnly = errors.New("client is read-only")
+
+// WithReadOnly permits only requests that read data, all mutating methods
+// return ErrReadOnly
+func WithReadOnly() Option {
+	return func(s *SumSub) {
+		s.readOnly = true
+	}
+}
+
 // NewClient to sumsub server, prepare sumsub struct instance and obtain token
-func NewClient(addr, user, pass string) (*SumSub, error) {
+func NewClient(addr, user, pass string, opts ...Option) (*SumSub, error) {
+	return NewClientWithCredentials(addr, StaticCredentials{User: user, Pass: pass}, opts...)
+}
+
+// NewClientWithCredentials same as NewClient, but user and password are
+// requested from provider each time when token should be obtained
+func NewClientWithCredentials(addr string, creds CredentialsProvider, opts ...Option) (*SumSub, error) {
+	if creds == nil {
+		return nil, errors.New("credentials provider is nil")
+	}
+
 	u, err := urlx.ParseWithDefaultScheme(addr, "https")
 	if err != nil {
 		return nil, err
 	}
 
 	s := &SumSub{
-		url:  *u,
-		user: user,
-		pass: pass,
+		url:    *u,
+		creds:  creds,
+		client: newReq(requestTimeout),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if err := s.authenticate(); err != nil {
+		return s, fmt.Errorf("token not recieved: %v", err)
+	}
+
+	return s, nil
+}
+
+// URL of the api endpoint, urlpath is escaped path, segments with user values
+// should be escaped by url.PathEscape
+func (s *SumSub) URL(urlpath ...string) string {
+	u := s.url
+	u.RawPath = path.Join(urlpath...)
+	u.Path = u.RawPath
+	if p, err := url.PathUnescape(u.RawPath); err == nil {
+		u.Path = p
+	}
+	return u.String()
+}
+
+// newReq creates requests client with own http client, the default client of
+// the req package is created lazily without synchronization and races on the
+// first concurrent requests
+func newReq(timeout time.Duration) *req.Req {
+	r := req.New()
+	r.SetClient(&http.Client{Timeout: timeout})
+	return r
+}
+
+// httpClient returns requests client of the instance, it is created on first
+// use if the instance is not made by NewClient
+func (s *SumSub) httpClient() *req.Req {
+	s.clientOnce.Do(func() {
+		if s.client == nil {
+			s.client = newReq(requestTimeout)
+		}
+	})
+
+	return s.client
+}
+
+// authenticate obtain new token with actual credentials from provider
+func (s *SumSub) authenticate() error {
+	user, pass, err := s.creds.Credentials()
+	if err != nil {
+		return err
 	}
 
 	token, err := s.Authentication(user, pass)
+	if s.observer != nil {
+		s.observer.ObserveTokenRefresh(err)
+	}
 	if err != nil {
-		return s, fmt.Errorf("token not recieved: %v", err)
+		return err
 	}
 
 	s.token = token
 	s.tokenExpired = time.Now().Add(tokenLifetime)
 
-	return s, nil
+	return nil
 }
 
-func (s *SumSub) URL(urlpath ...string) string {
-	s.url.Path = path.Join(urlpath...)
-	return s.url.String()
-}
+// authHeader returns header with bearer token, token is renewed if it is
+// expired or was rejected by the server
+func (s *SumSub) authHeader() (req.Header, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == "" || time.Now().After(s.tokenExpired) {
+		if err := s.authenticate(); err != nil {
+			return nil, fmt.Errorf("token not recieved: %v", err)
+		}
+	}
 
-func (s *SumSub) authHeader() req.Header {
 	return req.Header{
 		"Authorization": "Bearer " + s.token,
+	}, nil
+}
+
+// do request to the api with authorization header, requests rejected with 429
+// status are retried if client is created with WithRetry option
+func (s *SumSub) do(method, urlpath string, v ...interface{}) (*req.Resp, error) {
+	if s.readOnly && method != "GET" && method != "HEAD" {
+		return nil, ErrReadOnly
+	}
+
+	if method != "GET" && method != "HEAD" {
+		if err := s.InvalidateApplicant(pathApplicantID(urlpath)); err != nil {
+			log.Warningf("cache of %s: %v", urlpath, err)
+		}
+	}
+
+	ctx := requestContext(v)
+	for attempt := 0; ; attempt++ {
+		resp, err := s.send(ctx, method, urlpath, v)
+		if err != nil || attempt >= s.retries || !replayable(v) || resp.Response().StatusCode != http.StatusTooManyRequests {
+			return resp, err
+		}
+		resp.Response().Body.Close()
+
+		if s.observer != nil {
+			s.observer.ObserveRetry(method, endpoint(urlpath), http.StatusTooManyRequests)
+		}
+
+		if err := sleep(ctx, retryDelay(resp.Response(), attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// send single request, it waits for the rate limit and reports the request to
+// tracer and observer
+func (s *SumSub) send(ctx context.Context, method, urlpath string, v []interface{}) (*req.Resp, error) {
+	header, err := s.authHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	wait, err := s.limiter.wait(ctx)
+	if wait > 0 && s.observer != nil {
+		s.observer.ObserveRateLimitWait(method, endpoint(urlpath), wait)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	v, end := s.startSpan(method, urlpath, v)
+
+	start := time.Now()
+	resp, err := s.httpClient().Do(method, s.URL(urlpath), append(v, header)...)
+
+	result := SpanResult{Err: err}
+	if err == nil {
+		result.StatusCode = resp.Response().StatusCode
+	}
+	if s.tracer != nil && result.StatusCode >= 400 {
+		var e Error
+		resp.ToJSON(&e)
+		result.CorrelationID = e.CorrelationId
+	}
+	end(result)
+
+	s.observeRequest(method, urlpath, result.StatusCode, start)
+
+	if result.StatusCode == 401 {
+		// token is revoked or credentials are rotated, obtain new token on next request
+		s.mu.Lock()
+		s.token = ""
+		s.mu.Unlock()
+	}
+
+	return resp, err
+}
+
+// requestContext returns context from request options v, background context
+// is returned if it is not set
+func requestContext(v []interface{}) context.Context {
+	for _, opt := range v {
+		if ctx, ok := opt.(context.Context); ok {
+			return ctx
+		}
+	}
+
+	return context.Background()
+}
+
+// Do request to the endpoint which is not wrapped by the client, it applies
+// authorization, read-only mode, rate limit and retries of the client, ctx is
+// context of the http request. Query values are formatted with fmt.Sprint,
+// body is sent as is if it is io.Reader, such requests are not retried,
+// otherwise it is encoded as json. Response is decoded into out if it is not
+// nil, api errors are returned as *Error
+func (s *SumSub) Do(ctx context.Context, method, urlpath string, query map[string]interface{}, body, out interface{}) error {
+	v := []interface{}{ctx}
+	if len(query) > 0 {
+		v = append(v, req.QueryParam(query))
+	}
+
+	switch body := body.(type) {
+	case nil:
+	case io.Reader:
+		v = append(v, body)
+	default:
+		v = append(v, req.BodyJSON(body))
+	}
+
+	resp, err := s.do(method, urlpath, v...)
+	if err := handleResponse(resp, err); err != nil {
+		return err
 	}
+
+	if out == nil {
+		return nil
+	}
+
+	return s.decode(resp, out)
 }
 
 // Authentication request to obtain `token`
@@ -83,7 +313,7 @@ func (s *SumSub) Authentication(user, pass string) (token string, err error) {
 	header := req.Header{
 		"Authorization": "Basic " + basic,
 	}
-	resp, err := req.Post(s.URL("/resources/auth/login"), header)
+	resp, err := s.httpClient().Post(s.URL("/resources/auth/login"), header)
 	if err != nil {
 		return "", err
 	} else if r := resp.Response(); r.StatusCode != 200 {
@@ -117,6 +347,13 @@ func (e Error) Error() string {
 	return fmt.Sprintf("%d %s", e.Code, e.Description)
 }
 
+// IsConflict returns true for api error caused by conflict with existing
+// entity, e.g. applicant with the same externalUserId
+func IsConflict(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Code == 409
+}
+
 func handleResponse(resp *req.Resp, err error) error {
 	if err != nil {
 		return err
@@ -144,28 +381,110 @@ type Applicant struct {
 
 	// request
 	ExternalUserID string   `json:"externalUserId"`
+	Type           string   `json:"type,omitempty"`
 	SourceKey      string   `json:"sourceKey,omitempty"`
 	Email          string   `json:"email,omitempty"`
 	Lang           string   `json:"lang,omitempty"`
-	Metadata       []string `json:"metadata,omitempty"`
+	Metadata       Metadata `json:"metadata,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+
+	Questionnaires []Questionnaire `json:"questionnaires,omitempty"`
 
 	Info           ApplicantInfo           `json:"info"`
+	FixedInfo      *ApplicantInfo          `json:"fixedInfo,omitempty"`
 	RequiredIdDocs ApplicantRequiredIDDocs `json:"requiredIdDocs"`
 
 	// response
 	ID           string `json:"id,omitempty"`
-	CreatedAt    string `json:"createdAt,omitempty"`
+	CreatedAt    Time   `json:"createdAt,omitempty"`
 	ClientID     string `json:"clientId,omitempty"`
 	InspectionID string `json:"inspectionId,omitempty"`
 	JobID        string `json:"jobId,omitempty"`
 	Env          string `json:"env,omitempty"`
 
-	Review struct {
-		CreateDate             string      `json:"createDate"`
-		ReviewResult           interface{} `json:"reviewResult"`
-		ReviewStatus           string      `json:"reviewStatus"`
-		NotificationFailureCnt int         `json:"notificationFailureCnt"`
-	} `json:"review,omitempty"`
+	// applicant is in the blocklist or whitelist
+	Blacklisted bool `json:"blacklisted,omitempty"`
+	Whitelisted bool `json:"whitelisted,omitempty"`
+
+	// MemberOf are company applicants the applicant is beneficiary of
+	MemberOf []ApplicantMembership `json:"memberOf,omitempty"`
+
+	// confirmation state of the declared phone and email
+	PhoneVerification *ContactVerification `json:"phoneVerification,omitempty"`
+	EmailVerification *ContactVerification `json:"emailVerification,omitempty"`
+
+	Review ApplicantReview `json:"review,omitempty"`
+
+	// RawJSON is applicant object as it is received from the api, it gives
+	// access to the fields which are not declared in the struct
+	RawJSON json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes applicant and keeps the raw object in RawJSON
+func (a *Applicant) UnmarshalJSON(data []byte) error {
+	type applicant Applicant
+	if err := json.Unmarshal(data, (*applicant)(a)); err != nil {
+		return err
+	}
+
+	a.RawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON encodes applicant, createdAt and review are set by sumsub, they
+// are omitted if they are empty, e.g. in the create request
+func (a Applicant) MarshalJSON() ([]byte, error) {
+	type applicant Applicant
+	v := struct {
+		applicant
+		CreatedAt *Time            `json:"createdAt,omitempty"`
+		Review    *ApplicantReview `json:"review,omitempty"`
+	}{applicant: applicant(a)}
+
+	if !a.CreatedAt.IsZero() || a.CreatedAt.Raw != "" {
+		v.CreatedAt = &a.CreatedAt
+	}
+	if a.Review.ReviewStatus != "" || !a.Review.CreateDate.IsZero() {
+		v.Review = &a.Review
+	}
+
+	return json.Marshal(v)
+}
+
+// ApplicantReview is state of the current review of the applicant
+type ApplicantReview struct {
+	LevelName  string `json:"levelName,omitempty"`
+	AttemptCnt int    `json:"attemptCnt"`
+
+	CreateDate            Time  `json:"createDate"`
+	ReviewDate            Time  `json:"reviewDate,omitempty"`
+	ElapsedSincePendingMs int64 `json:"elapsedSincePendingMs,omitempty"`
+
+	ReviewResult     ReviewResult `json:"reviewResult"`
+	ReviewStatus     string       `json:"reviewStatus"`
+	ReviewReasonCode string       `json:"reviewReasonCode,omitempty"`
+	Reprocessing     bool         `json:"reprocessing"`
+	Priority         int          `json:"priority"`
+
+	NotificationFailureCnt int `json:"notificationFailureCnt"`
+}
+
+// IsPhoneConfirmed returns true if applicant confirmed the declared phone
+func (a Applicant) IsPhoneConfirmed() bool {
+	return a.PhoneVerification != nil && a.PhoneVerification.Confirmed
+}
+
+// IsEmailConfirmed returns true if applicant confirmed the declared email
+func (a Applicant) IsEmailConfirmed() bool {
+	return a.EmailVerification != nil && a.EmailVerification.Confirmed
+}
+
+// ContactVerification is confirmation state of the applicant phone or email
+type ContactVerification struct {
+	Value       string `json:"value,omitempty"`
+	Confirmed   bool   `json:"confirmed"`
+	SentAt      Time   `json:"sentAt,omitempty"`
+	ConfirmedAt Time   `json:"confirmedAt,omitempty"`
 }
 
 type ApplicantInfo struct {
@@ -173,23 +492,78 @@ type ApplicantInfo struct {
 	LastName   string `json:"lastName,omitempty"`
 	MiddleName string `json:"middleName,omitempty"`
 
-	Gender       string `json:"gender,omitempty"`
-	DateOfBirth  string `json:"dob,omitempty"`
-	PlaceOfBirth string `json:"placeOfBirth,omitempty"`
+	LegalName string `json:"legalName,omitempty"`
+
+	Gender         string `json:"gender,omitempty"`
+	DateOfBirth    Date   `json:"dob,omitempty"`
+	PlaceOfBirth   string `json:"placeOfBirth,omitempty"`
+	CountryOfBirth string `json:"countryOfBirth,omitempty"`
+	StateOfBirth   string `json:"stateOfBirth,omitempty"`
+	Nationality    string `json:"nationality,omitempty"`
 
 	Country string `json:"country,omitempty"`
 	Phone   string `json:"phone,omitempty"`
 
+	// tax identification number and country of tax residence
+	TIN                 string `json:"tin,omitempty"`
+	TaxResidenceCountry string `json:"taxResidenceCountry,omitempty"`
+
 	Addresses []Address `json:"addresses,omitempty"`
+
+	// IDDocs is data read by sumsub from the applicant documents
+	IDDocs []IDDoc `json:"idDocs,omitempty"`
+
+	// CompanyInfo is set for company applicants
+	CompanyInfo *CompanyInfo `json:"companyInfo,omitempty"`
+}
+
+// IDDoc is machine-read data of the applicant document
+type IDDoc struct {
+	IDDocType    string `json:"idDocType"`
+	IDDocSubType string `json:"idDocSubType,omitempty"`
+	Country      string `json:"country,omitempty"`
+
+	FirstName    string `json:"firstName,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	MiddleName   string `json:"middleName,omitempty"`
+	Gender       string `json:"gender,omitempty"`
+	DateOfBirth  Date   `json:"dob,omitempty"`
+	PlaceOfBirth string `json:"placeOfBirth,omitempty"`
+	Nationality  string `json:"nationality,omitempty"`
+
+	Number         string `json:"number,omitempty"`
+	IssuedDate     Date   `json:"issuedDate,omitempty"`
+	ValidUntil     Date   `json:"validUntil,omitempty"`
+	IssueAuthority string `json:"issueAuthority,omitempty"`
+
+	// data of the company documents
+	CompanyName        string `json:"companyName,omitempty"`
+	RegistrationNumber string `json:"registrationNumber,omitempty"`
+	IncorporatedOn     Date   `json:"incorporatedOn,omitempty"`
+	LegalAddress       string `json:"legalAddress,omitempty"`
+
+	MRZLine1 string `json:"mrzLine1,omitempty"`
+	MRZLine2 string `json:"mrzLine2,omitempty"`
+	MRZLine3 string `json:"mrzLine3,omitempty"`
 }
 
 type Address struct {
-	Country   string `json:"country,omitempty"`
-	PostCode  string `json:"postCode,omitempty"`
-	Town      string `json:"town,omitempty"`
-	Street    string `json:"street,omitempty"`
-	SubStreet string `json:"subStreet,omitempty"`
-	State     string `json:"state,omitempty"`
+	Country        string `json:"country,omitempty"`
+	PostCode       string `json:"postCode,omitempty"`
+	Town           string `json:"town,omitempty"`
+	Street         string `json:"street,omitempty"`
+	SubStreet      string `json:"subStreet,omitempty"`
+	State          string `json:"state,omitempty"`
+	BuildingName   string `json:"buildingName,omitempty"`
+	BuildingNumber string `json:"buildingNumber,omitempty"`
+	FlatNumber     string `json:"flatNumber,omitempty"`
+
+	// period of living at the address
+	StartDate Date `json:"startDate,omitempty"`
+	EndDate   Date `json:"endDate,omitempty"`
+
+	// FormattedAddress is full address in one line, it is returned by sumsub
+	FormattedAddress string `json:"formattedAddress,omitempty"`
 }
 
 type ApplicantRequiredIDDocs struct {
@@ -201,12 +575,21 @@ type ApplicantRequiredIDDocs struct {
 }
 
 const (
-	IDDocSetType_IDENTITY           = "IDENTITY"
-	IDDocSetType_IDENTITY2          = "IDENTITY2"
-	IDDocSetType_SELFIE             = "SELFIE"
-	IDDocSetType_SELFIE2            = "SELFIE2"
-	IDDocSetType_PROOF_OF_RESIDENCE = "PROOF_OF_RESIDENCE"
-	IDDocSetType_PAYMENT_METHODS    = "PAYMENT_METHODS"
+	IDDocSetType_IDENTITY            = "IDENTITY"
+	IDDocSetType_IDENTITY2           = "IDENTITY2"
+	IDDocSetType_IDENTITY3           = "IDENTITY3"
+	IDDocSetType_IDENTITY4           = "IDENTITY4"
+	IDDocSetType_SELFIE              = "SELFIE"
+	IDDocSetType_SELFIE2             = "SELFIE2"
+	IDDocSetType_PROOF_OF_RESIDENCE  = "PROOF_OF_RESIDENCE"
+	IDDocSetType_PROOF_OF_RESIDENCE2 = "PROOF_OF_RESIDENCE2"
+	IDDocSetType_PAYMENT_METHODS     = "PAYMENT_METHODS"
+	IDDocSetType_APPLICANT_DATA      = "APPLICANT_DATA"
+	IDDocSetType_PHONE_VERIFICATION  = "PHONE_VERIFICATION"
+	IDDocSetType_EMAIL_VERIFICATION  = "EMAIL_VERIFICATION"
+	IDDocSetType_QUESTIONNAIRE       = "QUESTIONNAIRE"
+	IDDocSetType_E_KYC               = "E_KYC"
+	IDDocSetType_COMPANY             = "COMPANY"
 )
 
 const (
@@ -215,6 +598,7 @@ const (
 	DocSetType_DRIVERS                          = "DRIVERS"
 	DocSetType_BANK_CARD                        = "BANK_CARD"
 	DocSetType_UTILITY_BILL                     = "UTILITY_BILL"
+	DocSetType_UTILITY_BILL2                    = "UTILITY_BILL2"
 	DocSetType_BANK_STATEMENT                   = "BANK_STATEMENT"
 	DocSetType_SNILS                            = "SNILS"
 	DocSetType_SELFIE                           = "SELFIE"
@@ -228,10 +612,30 @@ const (
 	DocSetType_DRIVERS_TRANSLATION              = "DRIVERS_TRANSLATION"
 	DocSetType_INVESTOR_DOC                     = "INVESTOR_DOC"
 	DocSetType_VEHICLE_REGISTRATION_CERTIFICATE = "VEHICLE_REGISTRATION_CERTIFICATE"
-	DocSetType_INCOME_SOURCE                    = "INCOME SOURCE"
+	DocSetType_INCOME_SOURCE                    = "INCOME_SOURCE"
+	DocSetType_PAYMENT_METHOD                   = "PAYMENT_METHOD"
+	DocSetType_COVID_VACCINATION_FORM           = "COVID_VACCINATION_FORM"
+	DocSetType_ARBITRARY_DOC                    = "ARBITRARY_DOC"
 	DocSetType_OTHER                            = "OTHER"
 )
 
+// company document types, company details of the document are set in
+// CompanyName, RegistrationNumber, IncorporatedOn and LegalAddress fields of
+// the document metadata
+const (
+	DocSetType_COMPANY_DOC            = "COMPANY_DOC"
+	DocSetType_INCORPORATION_CERT     = "INCORPORATION_CERT"
+	DocSetType_INCORPORATION_ARTICLES = "INCORPORATION_ARTICLES"
+	DocSetType_SHAREHOLDER_REGISTRY   = "SHAREHOLDER_REGISTRY"
+	DocSetType_DIRECTORS_REGISTRY     = "DIRECTORS_REGISTRY"
+	DocSetType_STATE_REGISTRY         = "STATE_REGISTRY"
+	DocSetType_GOOD_STANDING_CERT     = "GOOD_STANDING_CERT"
+	DocSetType_POWER_OF_ATTORNEY      = "POWER_OF_ATTORNEY"
+	DocSetType_TRUST_AGREEMENT        = "TRUST_AGREEMENT"
+	DocSetType_INFORMATION_STATEMENT  = "INFORMATION_STATEMENT"
+	DocSetType_PROOF_OF_ADDRESS       = "PROOF_OF_ADDRESS"
+)
+
 const (
 	DocSetSubTypeFront = "FRONT_SIDE"
 	DocSetSubTypeBack  = "BACK_SIDE"
@@ -248,15 +652,36 @@ type ApplicantDoc struct {
 // CreateApplicant entity representing one physical person. It may have several
 // ID documents attached, like an ID card or a passport. Many additional photos
 // of different documents can be attached to the same applicant.
+// Applicant is validated before request if client is created with
+// WithValidation option
 // POST /resources/applicants
 // https://developers.sumsub.com/#creating-an-applicant
 func (s *SumSub) CreateApplicant(a *Applicant) error {
-	resp, err := req.Post(s.URL("resources/applicants"), s.authHeader(), req.BodyJSON(a))
+	if s.validate {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+	}
+
+	resp, err := s.do("POST", "resources/applicants", req.BodyJSON(a))
 	if err := handleResponse(resp, err); err != nil {
 		return err
 	}
 
-	return resp.ToJSON(&a)
+	return s.decode(resp, &a)
+}
+
+// UpdateFixedInfo sets info verified by the client, unlike the info it can't
+// be changed by the applicant, only specified fields are changed
+// PATCH /resources/applicants/{applicantId}/info/fixedInfo
+func (s *SumSub) UpdateFixedInfo(id string, info ApplicantInfo) (fixed ApplicantInfo, err error) {
+	resp, err := s.do("PATCH", "resources/applicants/"+id+"/info/fixedInfo", req.BodyJSON(info))
+	if err := handleResponse(resp, err); err != nil {
+		return fixed, err
+	}
+
+	err = s.decode(resp, &fixed)
+	return
 }
 
 type DocumentMetaData struct {
@@ -266,38 +691,43 @@ type DocumentMetaData struct {
 	FirstName    string `json:"firstName,omitempty"`
 	LastName     string `json:"lastName,omitempty"`
 	MiddleName   string `json:"middleName,omitempty"`
-	IssuedDate   string `json:"issuedDate,omitempty"`
-	ValidUntil   string `json:"validUntil,omitempty"`
+	IssuedDate   Date   `json:"issuedDate,omitempty"`
+	ValidUntil   Date   `json:"validUntil,omitempty"`
 	Number       string `json:"number,omitempty"`
-	DateOfBirth  string `json:"dob,omitempty"`
+	DateOfBirth  Date   `json:"dob,omitempty"`
 	PlaceOfBirth string `json:"placeOfBirth,omitempty"`
+
+	// metadata of the company documents, e.g. DocSetType_INCORPORATION_CERT
+	CompanyName        string `json:"companyName,omitempty"`
+	RegistrationNumber string `json:"registrationNumber,omitempty"`
+	IncorporatedOn     Date   `json:"incorporatedOn,omitempty"`
+	LegalAddress       string `json:"legalAddress,omitempty"`
+	IssueAuthority     string `json:"issueAuthority,omitempty"`
 }
 
-// AddDocument to applicant, it required metadata with description of the file
-func (s *SumSub) AddDocument(id string, metadata DocumentMetaData, file io.Reader, v interface{}) error {
-	var bufMetdata bytes.Buffer
-	json.NewEncoder(&bufMetdata).Encode(metadata)
+// DocumentResult is uploaded document metadata echoed by the server
+type DocumentResult struct {
+	DocumentMetaData
 
-	reqMetdata := req.FileUpload{
-		FieldName: "metadata",
-		File:      ioutil.NopCloser(&bufMetdata),
-	}
+	// ImageID is id of the uploaded image, it is returned in X-Image-Id header
+	ImageID string `json:"-"`
 
-	reqContent := req.FileUpload{
-		FieldName: "content",
-		File:      ioutil.NopCloser(file),
-	}
+	// Checksum is SHA-256 of the content, it is computed only if client is
+	// created with WithDuplicateCheck option
+	Checksum string `json:"-"`
 
-	resp, err := req.Post(s.URL("resources/applicants/"+id+"/info/idDoc"), s.authHeader(), reqMetdata, reqContent)
-	if err := handleResponse(resp, err); err != nil {
-		return err
-	}
+	// Duplicate is true if the same content was already uploaded
+	Duplicate bool `json:"-"`
 
-	if v == nil {
-		return nil
-	}
+	// Warnings are issues of the uploaded image found by sumsub, they are
+	// returned only if client is created with WithDocumentWarnings option
+	Warnings []DocumentWarning `json:"warnings,omitempty"`
+}
 
-	return resp.ToJSON(&v)
+// AddDocument to applicant, it required metadata with description of the file
+// POST /resources/applicants/{applicantId}/info/idDoc
+func (s *SumSub) AddDocument(id string, metadata DocumentMetaData, file io.Reader) (DocumentResult, error) {
+	return s.UploadDocument(id, Document{Metadata: metadata, Content: file})
 }
 
 type applicantsList struct {
@@ -308,20 +738,64 @@ type applicantsList struct {
 }
 
 func (s *SumSub) GetApplicant(id string) (a Applicant, err error) {
-	resp, err := req.Get(s.URL("resources/applicants/"+id), s.authHeader())
+	if s.cached(cacheKeyApplicant+id, &a) {
+		return a, nil
+	}
+
+	return s.fetchApplicant(id)
+}
+
+// fetchApplicant requests applicant bypassing the cache, cached value is
+// replaced by the response
+func (s *SumSub) fetchApplicant(id string) (a Applicant, err error) {
+	resp, err := s.do("GET", "resources/applicants/"+id)
 	if err := handleResponse(resp, err); err != nil {
 		return a, err
 	}
 
 	var list applicantsList
-	if err := resp.ToJSON(&list); err != nil {
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
 		return a, err
 	}
 	if len(list.List.Items) == 0 {
 		return a, errors.New("applicant not found")
 	}
 
-	return list.List.Items[0], nil
+	a = list.List.Items[0]
+	s.setCached(cacheKeyApplicant+id, a.RawJSON)
+
+	return a, err
+}
+
+// ListApplicants returns applicants page and total count of applicants
+// GET /resources/applicants
+func (s *SumSub) ListApplicants(offset, limit int) (items []Applicant, total int, err error) {
+	resp, err := s.do("GET", "resources/applicants", req.QueryParam{"offset": offset, "limit": limit})
+	if err := handleResponse(resp, err); err != nil {
+		return nil, 0, err
+	}
+
+	var list applicantsList
+	err = s.decode(resp, &list)
+	if err != nil && !isSchemaError(err) {
+		return nil, 0, err
+	}
+
+	return list.List.Items, list.List.TotalItems, err
+}
+
+// GetApplicantOne returns full applicant record including documents data
+// extracted by sumsub in Info.IDDocs
+// GET /resources/applicants/{applicantId}/one
+func (s *SumSub) GetApplicantOne(id string) (a Applicant, err error) {
+	resp, err := s.do("GET", "resources/applicants/"+id+"/one")
+	if err := handleResponse(resp, err); err != nil {
+		return a, err
+	}
+
+	err = s.decode(resp, &a)
+	return
 }
 
 type ApplicantStatus struct {
@@ -330,13 +804,27 @@ type ApplicantStatus struct {
 	ApplicantID  string `json:"applicantId"`
 	JobID        string `json:"jobId"`
 
-	CreateDate string `json:"createDate"`
-	StartDate  string `json:"startDate"`
+	CreateDate Time `json:"createDate"`
+	StartDate  Time `json:"startDate"`
 
 	ReviewResult ReviewResult `json:"reviewResult"`
 
 	ReviewStatus           string `json:"reviewStatus"`
 	NotificationFailureCnt int    `json:"notificationFailureCnt"`
+
+	// RawJSON is status object as it is received from the api
+	RawJSON json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes status and keeps the raw object in RawJSON
+func (status *ApplicantStatus) UnmarshalJSON(data []byte) error {
+	type applicantStatus ApplicantStatus
+	if err := json.Unmarshal(data, (*applicantStatus)(status)); err != nil {
+		return err
+	}
+
+	status.RawJSON = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 func (status ApplicantStatus) IsCompleted() bool {
@@ -358,6 +846,19 @@ type ReviewResult struct {
 	CustomTouch       bool     `json:"customTouch"`
 }
 
+// UnmarshalJSON decodes review result tolerating values that are not objects,
+// e.g. null or empty string for applicants that are not reviewed yet
+func (result *ReviewResult) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		*result = ReviewResult{}
+		return nil
+	}
+
+	type reviewResult ReviewResult
+	return json.Unmarshal(data, (*reviewResult)(result))
+}
+
 const (
 	ReviewStatusInit                = "init"
 	ReviewStatusPending             = "pending"
@@ -365,6 +866,7 @@ const (
 	ReviewStatusCompleted           = "completed"
 	ReviewStatusCompletedSent       = "completedSent"
 	ReviewStatusCompletedSetFailure = "completedSentFailure"
+	ReviewStatusOnHold              = "onHold"
 )
 
 const (
@@ -372,23 +874,84 @@ const (
 	ReviewResultGREEN = "GREEN"
 )
 
+// review reject types, EXTERNAL is set when applicant is rejected by the
+// client decision rather than by sumsub review
+const (
+	ReviewRejectTypeFINAL    = "FINAL"
+	ReviewRejectTypeRETRY    = "RETRY"
+	ReviewRejectTypeEXTERNAL = "EXTERNAL"
+)
+
 func (s *SumSub) GetApplicantStatus(id string) (a ApplicantStatus, err error) {
-	resp, err := req.Get(s.URL("resources/applicants/"+id+"/status"), s.authHeader())
+	return s.getApplicantStatus(context.Background(), id)
+}
+
+func (s *SumSub) getApplicantStatus(ctx context.Context, id string) (a ApplicantStatus, err error) {
+	if s.cached(cacheKeyStatus+id, &a) {
+		return a, nil
+	}
+
+	resp, err := s.do("GET", "resources/applicants/"+id+"/status", ctx)
 	if err := handleResponse(resp, err); err != nil {
 		return a, err
 	}
 
-	err = resp.ToJSON(&a)
+	if err = s.decode(resp, &a); err == nil || isSchemaError(err) {
+		s.setCached(cacheKeyStatus+id, a.RawJSON)
+	}
 	return
 }
 
 type ApplicantCompleteRequest struct {
-	ReviewAnswer     string   `json:"reviewAnswer"`
-	RejectLabels     []string `json:"rejectLabels"`
-	ReviewRejectType string   `json:"reviewRejectType,omitempty"`
+	ReviewAnswer      string   `json:"reviewAnswer"`
+	RejectLabels      []string `json:"rejectLabels"`
+	ReviewRejectType  string   `json:"reviewRejectType,omitempty"`
+	ModerationComment string   `json:"moderationComment,omitempty"`
+	ClientComment     string   `json:"clientComment,omitempty"`
+
+	// ImageReviewResults are outcomes of the separate images by image id
+	ImageReviewResults map[string]ImageReviewResult `json:"imageReviewResults,omitempty"`
 }
 
+type ImageReviewResult struct {
+	ReviewAnswer      string   `json:"reviewAnswer"`
+	RejectLabels      []string `json:"rejectLabels,omitempty"`
+	ModerationComment string   `json:"moderationComment,omitempty"`
+}
+
+// ApplicantComplete simulates review result in the test environment
+// POST /resources/applicants/{applicantId}/status/testCompleted
 func (s *SumSub) ApplicantComplete(id string, data ApplicantCompleteRequest) error {
-	resp, err := req.Post(s.URL("resources/applicants/"+id+"/status/testCompleted"), s.authHeader(), req.BodyJSON(data))
+	resp, err := s.do("POST", "resources/applicants/"+id+"/status/testCompleted", req.BodyJSON(data))
+	return handleResponse(resp, err)
+}
+
+// DeactivateApplicant hides applicant, it is not processed and not shown in
+// the dashboard lists, but can be activated later
+// PATCH /resources/applicants/{applicantId}/presence/deactivated
+func (s *SumSub) DeactivateApplicant(id string) error {
+	resp, err := s.do("PATCH", "resources/applicants/"+id+"/presence/deactivated")
+	return handleResponse(resp, err)
+}
+
+// ActivateApplicant returns previously deactivated applicant
+// PATCH /resources/applicants/{applicantId}/presence/activated
+func (s *SumSub) ActivateApplicant(id string) error {
+	resp, err := s.do("PATCH", "resources/applicants/"+id+"/presence/activated")
+	return handleResponse(resp, err)
+}
+
+// BlocklistApplicant adds applicant to the blocklist with note describing
+// the reason
+// POST /resources/applicants/{applicantId}/blacklist?note=
+func (s *SumSub) BlocklistApplicant(id, note string) error {
+	resp, err := s.do("POST", "resources/applicants/"+id+"/blacklist", req.QueryParam{"note": note})
+	return handleResponse(resp, err)
+}
+
+// WhitelistApplicant marks applicant as trusted with note describing the reason
+// POST /resources/applicants/{applicantId}/whitelist?note=
+func (s *SumSub) WhitelistApplicant(id, note string) error {
+	resp, err := s.do("POST", "resources/applicants/"+id+"/whitelist", req.QueryParam{"note": note})
 	return handleResponse(resp, err)
 }
@@ -1,8 +1,14 @@
 package sumsub
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -92,12 +98,16 @@ func TestAddDocument(t *testing.T) {
 		Country:   "USA",
 	}
 
-	var v interface{}
-	if err := sumsub.AddDocument(applicantID, metadata, f, v); err != nil {
+	result, err := sumsub.AddDocument(applicantID, metadata, f)
+	if err != nil {
 		t.Error(err)
 	}
 
-	t.Log(v)
+	if result.ImageID == "" {
+		t.Error("image id is empty")
+	}
+
+	t.Log(result)
 }
 
 func TestGetApplicant(t *testing.T) {
@@ -149,3 +159,190 @@ func TestApplicantComplete(t *testing.T) {
 
 	t.Log(status.IsPass())
 }
+
+func TestReadOnly(t *testing.T) {
+	u, _ := urlx.Parse(TestAddr)
+	s := &SumSub{
+		url: *u,
+	}
+	WithReadOnly()(s)
+
+	if err := s.DeactivateApplicant(applicantID); err != ErrReadOnly {
+		t.Error("expected ErrReadOnly, got", err)
+	}
+}
+
+func TestReviewResultDecode(t *testing.T) {
+	var a Applicant
+	data := []byte(`{"review": {"reviewStatus": "completed", "reviewResult": {"reviewAnswer": "RED", "rejectLabels": ["BAD_SELFIE"]}}}`)
+	if err := json.Unmarshal(data, &a); err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Review.ReviewResult.ReviewAnswer != ReviewResultRED || len(a.Review.ReviewResult.RejectLabels) != 1 {
+		t.Errorf("wrong review result %+v", a.Review.ReviewResult)
+	}
+
+	for _, result := range []string{`null`, `""`, `[]`} {
+		if err := json.Unmarshal([]byte(`{"review": {"reviewResult": `+result+`}}`), &a); err != nil {
+			t.Error(result, err)
+		}
+	}
+}
+
+func TestCompanyDocumentJSON(t *testing.T) {
+	md := DocumentMetaData{
+		IDDocType:          DocSetType_INCORPORATION_CERT,
+		Country:            "DEU",
+		CompanyName:        "Example GmbH",
+		RegistrationNumber: "HRB 12345",
+		IncorporatedOn:     "2015-03-01",
+		LegalAddress:       "Berlin",
+		IssueAuthority:     "Amtsgericht Berlin",
+	}
+
+	data, err := json.Marshal(md)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{`"companyName":"Example GmbH"`, `"registrationNumber":"HRB 12345"`, `"incorporatedOn":"2015-03-01"`, `"legalAddress":"Berlin"`, `"issueAuthority":"Amtsgericht Berlin"`} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("%s not found in %s", s, data)
+		}
+	}
+
+	var decoded DocumentMetaData
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != md {
+		t.Errorf("metadata is changed after round trip %+v %v", decoded, err)
+	}
+
+	data, err = json.Marshal(DocumentMetaData{IDDocType: DocSetType_PASSPORT, Country: "GBR"})
+	if err != nil || strings.Contains(string(data), "company") || strings.Contains(string(data), "incorporatedOn") {
+		t.Error("empty company fields should be omitted", string(data), err)
+	}
+
+	var info ApplicantInfo
+	if err := json.Unmarshal([]byte(`{"idDocs": [{"idDocType": "SHAREHOLDER_REGISTRY", "country": "DEU", "companyName": "Example GmbH", "registrationNumber": "HRB 12345", "incorporatedOn": "2015-03-01", "legalAddress": "Berlin"}]}`), &info); err != nil {
+		t.Fatal(err)
+	}
+	doc := info.IDDocs[0]
+	if doc.CompanyName != "Example GmbH" || doc.RegistrationNumber != "HRB 12345" || doc.IncorporatedOn != "2015-03-01" || doc.LegalAddress != "Berlin" {
+		t.Errorf("wrong company document %+v", doc)
+	}
+}
+
+func TestRawJSON(t *testing.T) {
+	var a Applicant
+	data := []byte(`{"id": "id", "newField": {"value": 1}}`)
+	if err := json.Unmarshal(data, &a); err != nil {
+		t.Fatal(err)
+	}
+
+	var extra struct {
+		NewField struct{ Value int }
+	}
+	if err := json.Unmarshal(a.RawJSON, &extra); err != nil || a.ID != "id" || extra.NewField.Value != 1 {
+		t.Error("raw json is not kept", string(a.RawJSON), err)
+	}
+
+	var status ApplicantStatus
+	if err := json.Unmarshal([]byte(`{"reviewStatus": "pending", "levelName": "basic"}`), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.ReviewStatus != ReviewStatusPending || string(status.RawJSON) != `{"reviewStatus": "pending", "levelName": "basic"}` {
+		t.Error("raw json is not kept", string(status.RawJSON))
+	}
+}
+
+func TestDo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path != "/resources/custom" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"description": "not found", "code": 404}`)
+			return
+		}
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprintf(w, `{"limit": %q, "name": %q}`, r.URL.Query().Get("limit"), body["name"])
+	}))
+	defer srv.Close()
+
+	u, _ := urlx.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+
+	var out struct{ Limit, Name string }
+	err := s.Do(context.Background(), "POST", "/resources/custom", map[string]interface{}{"limit": 10}, map[string]string{"name": "test"}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Limit != "10" || out.Name != "test" {
+		t.Errorf("unexpected response %+v", out)
+	}
+
+	err = s.Do(context.Background(), "GET", "/resources/unknown", nil, nil, nil)
+	if e, ok := err.(*Error); !ok || e.Code != 404 || e.Description != "not found" {
+		t.Errorf("expected api error, got %v", err)
+	}
+}
+
+func TestDoRetry(t *testing.T) {
+	var requests, logins int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/resources/auth/login":
+			atomic.AddInt32(&logins, 1)
+			fmt.Fprint(w, `{"status": "ok", "payload": "new-token"}`)
+		case r.URL.Path == "/resources/revoked":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.Method == "GET":
+			fmt.Fprintf(w, `{"applicantId": "5cb56e8e0a975a35f333cb83", "reviewStatus": "pending", "auth": %q}`, r.Header.Get("Authorization"))
+		case atomic.AddInt32(&requests, 1) < 3:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	u, _ := urlx.Parse(srv.URL)
+	s := &SumSub{url: *u, creds: StaticCredentials{}, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+	WithCache(nil, time.Minute)(s)
+	WithRetry(2)(s)
+
+	const id = "5cb56e8e0a975a35f333cb83"
+	if _, err := s.GetApplicantStatus(id); err != nil {
+		t.Fatal(err)
+	}
+
+	err := s.Do(context.Background(), "POST", "/resources/applicants/"+id+"/status/pending", nil, nil, nil)
+	if err != nil || atomic.LoadInt32(&requests) != 3 {
+		t.Fatal("request is not retried", requests, err)
+	}
+	if _, ok, _ := s.cache.Get(cacheKeyStatus + id); ok {
+		t.Error("cache is not invalidated by mutating request")
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	err = s.Do(context.Background(), "POST", "/resources/applicants/"+id+"/status/pending", nil, strings.NewReader("{}"), nil)
+	if e, ok := err.(*Error); !ok || e.Code != http.StatusTooManyRequests || atomic.LoadInt32(&requests) != 1 {
+		t.Error("request with body reader should not be retried", requests, err)
+	}
+
+	err = s.Do(context.Background(), "GET", "/resources/revoked", nil, nil, nil)
+	if e, ok := err.(*Error); !ok || e.Code != http.StatusUnauthorized {
+		t.Fatal("expected unauthorized error, got", err)
+	}
+
+	var status struct{ Auth string }
+	if err := s.Do(context.Background(), "GET", "/resources/applicants/"+id+"/status", nil, nil, &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.Auth != "Bearer new-token" || atomic.LoadInt32(&logins) != 1 {
+		t.Error("token is not renewed after 401", status.Auth, logins)
+	}
+}
@@ -0,0 +1,1258 @@
+package sumsubmock
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sg3des/sumsub"
+)
+
+// ErrNotImplemented is returned by Client methods which func is not set
+var ErrNotImplemented = errors.New("sumsubmock: method is not implemented")
+
+// Client is sumsub.API implementation with behaviour defined by func fields,
+// methods with nil func return zero values and ErrNotImplemented. Names of the
+// called methods are recorded and returned by Calls
+type Client struct {
+	ActivateApplicantFunc              func(string) error
+	AddApplicantAgreementFunc          func(string, sumsub.Agreement) (sumsub.Agreement, error)
+	AddApplicantNoteFunc               func(string, string) (sumsub.Note, error)
+	AddApplicantTagsFunc               func(string, ...string) error
+	AddBeneficiaryFunc                 func(string, sumsub.Beneficiary) (sumsub.Beneficiary, error)
+	AddDocumentFunc                    func(string, sumsub.DocumentMetaData, io.Reader) (sumsub.DocumentResult, error)
+	AddDocumentBytesFunc               func(string, sumsub.DocumentMetaData, []byte) (sumsub.DocumentResult, error)
+	AddDocumentFromURLFunc             func(string, sumsub.DocumentMetaData, string, int64) (sumsub.DocumentResult, error)
+	AddDocumentSidesFunc               func(string, sumsub.DocumentMetaData, io.Reader, io.Reader) (sumsub.DocumentResult, sumsub.DocumentResult, error)
+	AddTransactionNoteFunc             func(string, string) (sumsub.Note, error)
+	AddVideoSelfieFunc                 func(string, sumsub.DocumentMetaData, io.Reader, sumsub.VideoOptions) (sumsub.DocumentResult, error)
+	AllApplicantActionsFunc            func(string, sumsub.ActionFilter) ([]sumsub.ApplicantAction, error)
+	ApplicantCompleteFunc              func(string, sumsub.ApplicantCompleteRequest) error
+	BankCardResultFunc                 func(string) (sumsub.BankCardResult, error)
+	BlocklistApplicantFunc             func(string, string) error
+	ConfirmAMLHitFunc                  func(string, string, string) error
+	ConfirmContactFunc                 func(string, string, string) error
+	CreateApplicantFunc                func(*sumsub.Applicant) error
+	CreateApplicantActionFunc          func(string, string, sumsub.ApplicantActionRequest) (sumsub.ApplicantAction, error)
+	CreateApplicantsFunc               func([]sumsub.Applicant, int) []sumsub.CreateResult
+	CreateCompanyApplicantFunc         func(*sumsub.Applicant) error
+	CreateFaceAuthSessionFunc          func(string, string, string, time.Duration) (sumsub.FaceAuthSession, error)
+	DeactivateApplicantFunc            func(string) error
+	DeactivateDocumentImageFunc        func(string, string) error
+	DismissAMLHitFunc                  func(string, string, string) error
+	DoFunc                             func(context.Context, string, string, map[string]interface{}, interface{}, interface{}) error
+	DownloadApplicantImagesFunc        func(string, int, func(sumsub.DocumentImage) error) error
+	GenerateAccessTokenFunc            func(string, string, time.Duration) (sumsub.AccessToken, error)
+	GenerateAccessTokenWithOptionsFunc func(sumsub.AccessTokenOptions) (sumsub.AccessToken, error)
+	GenerateShareTokenFunc             func(string, string, time.Duration) (sumsub.ShareToken, error)
+	GenerateWebSDKLinkFunc             func(string, sumsub.WebSDKLinkOptions) (sumsub.WebSDKLink, error)
+	GetAMLChecksFunc                   func(string) ([]sumsub.AMLCheck, error)
+	GetAPIUsageFunc                    func(time.Time, time.Time) ([]sumsub.UsageCounter, error)
+	GetApplicantFunc                   func(string) (sumsub.Applicant, error)
+	GetApplicantActionFunc             func(string) (sumsub.ApplicantAction, error)
+	GetApplicantActionByExternalIDFunc func(string) (sumsub.ApplicantAction, error)
+	GetApplicantActionStatusFunc       func(string) (sumsub.ApplicantStatus, error)
+	GetApplicantAgreementsFunc         func(string) ([]sumsub.Agreement, error)
+	GetApplicantDeletionStatusFunc     func(string) (sumsub.DeletionStatus, error)
+	GetApplicantDocumentsFunc          func(string) ([]sumsub.DocumentResource, error)
+	GetApplicantEventsFunc             func(string, int, int) ([]sumsub.ApplicantEvent, int, error)
+	GetApplicantNotesFunc              func(string) ([]sumsub.Note, error)
+	GetApplicantOneFunc                func(string) (sumsub.Applicant, error)
+	GetApplicantQuestionnairesFunc     func(string) ([]sumsub.Questionnaire, error)
+	GetApplicantReportFunc             func(string, string, string) ([]byte, error)
+	GetApplicantReviewHistoryFunc      func(string) ([]sumsub.ReviewAttempt, error)
+	GetApplicantStatusFunc             func(string) (sumsub.ApplicantStatus, error)
+	GetApplicantStatusesFunc           func(context.Context, []string, int) map[string]sumsub.StatusResult
+	GetApplicantTagsFunc               func(string) ([]string, error)
+	GetAuditLogFunc                    func(time.Time, time.Time, int, int) ([]sumsub.AuditEvent, int, error)
+	GetCompanyRegistryDataFunc         func(string) ([]sumsub.CompanyCheck, error)
+	GetCountryDocTypesFunc             func(string) ([]string, error)
+	GetDocumentImageFunc               func(string, string) ([]byte, string, error)
+	GetFaceAuthResultFunc              func(sumsub.FaceAuthSession) (sumsub.ApplicantAction, error)
+	GetLatestChecksFunc                func(string, string) ([]sumsub.Check, error)
+	GetLevelFunc                       func(string) (sumsub.Level, error)
+	GetModerationStatesFunc            func(string) ([]sumsub.ModerationState, error)
+	GetOnboardingStateFunc             func(string) (sumsub.OnboardingState, error)
+	GetOwnershipTreeFunc               func(string) (*sumsub.OwnershipNode, error)
+	GetQuestionnaireDefinitionsFunc    func(string) ([]sumsub.QuestionnaireDefinition, error)
+	GetRequiredDocsStatusFunc          func(string) (map[string]*sumsub.RequiredDocStatus, error)
+	GetSupportedDocTypesFunc           func() (map[string][]string, error)
+	GetTransactionFunc                 func(string) (sumsub.TransactionResult, error)
+	GetTransactionByTxnIDFunc          func(string) (sumsub.TransactionResult, error)
+	GetTravelRuleStatusFunc            func(string) (string, error)
+	GetWebhookActionFunc               func(*sumsub.ApplicantActionWebhook) (sumsub.ApplicantAction, error)
+	HealthFunc                         func() (sumsub.HealthStatus, error)
+	ImportApplicantFunc                func(string) (sumsub.Applicant, error)
+	InvalidateApplicantFunc            func(string) error
+	InviteBeneficiariesFunc            func(string, string, sumsub.WebSDKLinkOptions) ([]sumsub.BeneficiaryInvitation, error)
+	LinkApplicantToCompanyFunc         func(string, string, []string, float64) (sumsub.Beneficiary, error)
+	ListApplicantActionsFunc           func(string, int, int) ([]sumsub.ApplicantAction, int, error)
+	ListApplicantsFunc                 func(int, int) ([]sumsub.Applicant, int, error)
+	ListLevelsFunc                     func() ([]sumsub.Level, error)
+	OutstandingBeneficiariesFunc       func(string) ([]sumsub.Beneficiary, error)
+	PingFunc                           func() error
+	RefreshAccessTokenFunc             func(sumsub.AccessToken) (sumsub.AccessToken, error)
+	RemoveApplicantTagsFunc            func(string, ...string) error
+	RemoveBeneficiaryFunc              func(string, string) error
+	RequestActionCheckFunc             func(string) error
+	RequestApplicantDeletionFunc       func(string) (sumsub.DeletionStatus, error)
+	RescoreTransactionFunc             func(string) (sumsub.TransactionResult, error)
+	ResendWebhookFunc                  func(string) error
+	ResolveAMLHitFunc                  func(string, string, string, string) error
+	ReviewReportFunc                   func(time.Time, time.Time) (*sumsub.ReviewReport, error)
+	RotateDocumentImageFunc            func(string, string, int) error
+	SendConfirmationCodeFunc           func(string, string) error
+	SendTestWebhookFunc                func(sumsub.TestWebhookRequest) error
+	SetApplicantPriorityFunc           func(string, int) error
+	SetApplicantTagsFunc               func(string, []string) error
+	SetOngoingMonitoringFunc           func(string, bool) error
+	SetTransactionTagsFunc             func(string, []string) error
+	SimulateApprovalFunc               func(string) error
+	SimulateFinalRejectionFunc         func(string, string, ...string) error
+	SimulateImageReviewsFunc           func(string, map[string]sumsub.ImageReviewResult) error
+	SimulateRetryFunc                  func(string, string, ...string) error
+	SubmitQuestionnaireFunc            func(string, sumsub.Questionnaire) error
+	SubmitTransactionFunc              func(string, sumsub.Transaction) (sumsub.TransactionResult, error)
+	SubmitTravelRuleTransferFunc       func(string, sumsub.TravelRuleTransfer) (sumsub.TransactionResult, error)
+	UpdateBeneficiaryFunc              func(string, sumsub.Beneficiary) (sumsub.Beneficiary, error)
+	UpdateCompanyInfoFunc              func(string, sumsub.CompanyInfo) (sumsub.CompanyInfo, error)
+	UpdateFixedInfoFunc                func(string, sumsub.ApplicantInfo) (sumsub.ApplicantInfo, error)
+	UpdateTransactionPropsFunc         func(string, map[string]string) error
+	UploadActionDocumentFunc           func(string, sumsub.Document) (sumsub.DocumentResult, error)
+	UploadDocumentFunc                 func(string, sumsub.Document) (sumsub.DocumentResult, error)
+	VerifyBankCardFunc                 func(string, string, string, sumsub.BankCard) (sumsub.BankCardVerification, error)
+	WaitForReviewFunc                  func(context.Context, string, sumsub.PollOptions) (sumsub.ApplicantStatus, error)
+	WalkAuditLogFunc                   func(time.Time, time.Time, func(sumsub.AuditEvent) error) error
+	WhitelistApplicantFunc             func(string, string) error
+	WriteApplicantReportFunc           func(io.Writer, string, string, string) error
+	WriteDocumentImageFunc             func(io.Writer, string, string) (string, error)
+
+	mu    sync.Mutex
+	calls []string
+}
+
+var _ sumsub.API = (*Client)(nil)
+
+// Calls returns names of the called methods in order of calls
+func (m *Client) Calls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]string(nil), m.calls...)
+}
+
+func (m *Client) record(method string) {
+	m.mu.Lock()
+	m.calls = append(m.calls, method)
+	m.mu.Unlock()
+}
+
+func (m *Client) ActivateApplicant(id string) (err error) {
+	m.record("ActivateApplicant")
+	if m.ActivateApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ActivateApplicantFunc(id)
+}
+
+func (m *Client) AddApplicantAgreement(id string, agreement sumsub.Agreement) (r0 sumsub.Agreement, err error) {
+	m.record("AddApplicantAgreement")
+	if m.AddApplicantAgreementFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddApplicantAgreementFunc(id, agreement)
+}
+
+func (m *Client) AddApplicantNote(id string, text string) (r0 sumsub.Note, err error) {
+	m.record("AddApplicantNote")
+	if m.AddApplicantNoteFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddApplicantNoteFunc(id, text)
+}
+
+func (m *Client) AddApplicantTags(id string, tags ...string) (err error) {
+	m.record("AddApplicantTags")
+	if m.AddApplicantTagsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddApplicantTagsFunc(id, tags...)
+}
+
+func (m *Client) AddBeneficiary(id string, b sumsub.Beneficiary) (r0 sumsub.Beneficiary, err error) {
+	m.record("AddBeneficiary")
+	if m.AddBeneficiaryFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddBeneficiaryFunc(id, b)
+}
+
+func (m *Client) AddDocument(id string, metadata sumsub.DocumentMetaData, file io.Reader) (r0 sumsub.DocumentResult, err error) {
+	m.record("AddDocument")
+	if m.AddDocumentFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddDocumentFunc(id, metadata, file)
+}
+
+func (m *Client) AddDocumentBytes(id string, metadata sumsub.DocumentMetaData, data []byte) (r0 sumsub.DocumentResult, err error) {
+	m.record("AddDocumentBytes")
+	if m.AddDocumentBytesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddDocumentBytesFunc(id, metadata, data)
+}
+
+func (m *Client) AddDocumentFromURL(id string, metadata sumsub.DocumentMetaData, fileURL string, maxSize int64) (r0 sumsub.DocumentResult, err error) {
+	m.record("AddDocumentFromURL")
+	if m.AddDocumentFromURLFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddDocumentFromURLFunc(id, metadata, fileURL, maxSize)
+}
+
+func (m *Client) AddDocumentSides(id string, metadata sumsub.DocumentMetaData, front io.Reader, back io.Reader) (r0 sumsub.DocumentResult, r1 sumsub.DocumentResult, err error) {
+	m.record("AddDocumentSides")
+	if m.AddDocumentSidesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddDocumentSidesFunc(id, metadata, front, back)
+}
+
+func (m *Client) AddTransactionNote(id string, text string) (r0 sumsub.Note, err error) {
+	m.record("AddTransactionNote")
+	if m.AddTransactionNoteFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddTransactionNoteFunc(id, text)
+}
+
+func (m *Client) AddVideoSelfie(id string, metadata sumsub.DocumentMetaData, video io.Reader, opts sumsub.VideoOptions) (r0 sumsub.DocumentResult, err error) {
+	m.record("AddVideoSelfie")
+	if m.AddVideoSelfieFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AddVideoSelfieFunc(id, metadata, video, opts)
+}
+
+func (m *Client) AllApplicantActions(id string, filter sumsub.ActionFilter) (r0 []sumsub.ApplicantAction, err error) {
+	m.record("AllApplicantActions")
+	if m.AllApplicantActionsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.AllApplicantActionsFunc(id, filter)
+}
+
+func (m *Client) ApplicantComplete(id string, data sumsub.ApplicantCompleteRequest) (err error) {
+	m.record("ApplicantComplete")
+	if m.ApplicantCompleteFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ApplicantCompleteFunc(id, data)
+}
+
+func (m *Client) BankCardResult(actionID string) (r0 sumsub.BankCardResult, err error) {
+	m.record("BankCardResult")
+	if m.BankCardResultFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.BankCardResultFunc(actionID)
+}
+
+func (m *Client) BlocklistApplicant(id string, note string) (err error) {
+	m.record("BlocklistApplicant")
+	if m.BlocklistApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.BlocklistApplicantFunc(id, note)
+}
+
+func (m *Client) ConfirmAMLHit(checkID string, hitID string, comment string) (err error) {
+	m.record("ConfirmAMLHit")
+	if m.ConfirmAMLHitFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ConfirmAMLHitFunc(checkID, hitID, comment)
+}
+
+func (m *Client) ConfirmContact(id string, contact string, code string) (err error) {
+	m.record("ConfirmContact")
+	if m.ConfirmContactFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ConfirmContactFunc(id, contact, code)
+}
+
+func (m *Client) CreateApplicant(a *sumsub.Applicant) (err error) {
+	m.record("CreateApplicant")
+	if m.CreateApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.CreateApplicantFunc(a)
+}
+
+func (m *Client) CreateApplicantAction(id string, levelName string, data sumsub.ApplicantActionRequest) (r0 sumsub.ApplicantAction, err error) {
+	m.record("CreateApplicantAction")
+	if m.CreateApplicantActionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.CreateApplicantActionFunc(id, levelName, data)
+}
+
+func (m *Client) CreateApplicants(applicants []sumsub.Applicant, concurrency int) (r0 []sumsub.CreateResult) {
+	m.record("CreateApplicants")
+	if m.CreateApplicantsFunc == nil {
+		return
+	}
+
+	return m.CreateApplicantsFunc(applicants, concurrency)
+}
+
+func (m *Client) CreateCompanyApplicant(a *sumsub.Applicant) (err error) {
+	m.record("CreateCompanyApplicant")
+	if m.CreateCompanyApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.CreateCompanyApplicantFunc(a)
+}
+
+func (m *Client) CreateFaceAuthSession(userID string, levelName string, externalActionID string, ttl time.Duration) (r0 sumsub.FaceAuthSession, err error) {
+	m.record("CreateFaceAuthSession")
+	if m.CreateFaceAuthSessionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.CreateFaceAuthSessionFunc(userID, levelName, externalActionID, ttl)
+}
+
+func (m *Client) DeactivateApplicant(id string) (err error) {
+	m.record("DeactivateApplicant")
+	if m.DeactivateApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.DeactivateApplicantFunc(id)
+}
+
+func (m *Client) DeactivateDocumentImage(inspectionID string, imageID string) (err error) {
+	m.record("DeactivateDocumentImage")
+	if m.DeactivateDocumentImageFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.DeactivateDocumentImageFunc(inspectionID, imageID)
+}
+
+func (m *Client) DismissAMLHit(checkID string, hitID string, comment string) (err error) {
+	m.record("DismissAMLHit")
+	if m.DismissAMLHitFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.DismissAMLHitFunc(checkID, hitID, comment)
+}
+
+func (m *Client) Do(ctx context.Context, method string, urlpath string, query map[string]interface{}, body interface{}, out interface{}) (err error) {
+	m.record("Do")
+	if m.DoFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.DoFunc(ctx, method, urlpath, query, body, out)
+}
+
+func (m *Client) DownloadApplicantImages(id string, concurrency int, fn func(sumsub.DocumentImage) error) (err error) {
+	m.record("DownloadApplicantImages")
+	if m.DownloadApplicantImagesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.DownloadApplicantImagesFunc(id, concurrency, fn)
+}
+
+func (m *Client) GenerateAccessToken(userID string, levelName string, ttl time.Duration) (r0 sumsub.AccessToken, err error) {
+	m.record("GenerateAccessToken")
+	if m.GenerateAccessTokenFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GenerateAccessTokenFunc(userID, levelName, ttl)
+}
+
+func (m *Client) GenerateAccessTokenWithOptions(opts sumsub.AccessTokenOptions) (r0 sumsub.AccessToken, err error) {
+	m.record("GenerateAccessTokenWithOptions")
+	if m.GenerateAccessTokenWithOptionsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GenerateAccessTokenWithOptionsFunc(opts)
+}
+
+func (m *Client) GenerateShareToken(applicantID string, forClientID string, ttl time.Duration) (r0 sumsub.ShareToken, err error) {
+	m.record("GenerateShareToken")
+	if m.GenerateShareTokenFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GenerateShareTokenFunc(applicantID, forClientID, ttl)
+}
+
+func (m *Client) GenerateWebSDKLink(levelName string, opts sumsub.WebSDKLinkOptions) (r0 sumsub.WebSDKLink, err error) {
+	m.record("GenerateWebSDKLink")
+	if m.GenerateWebSDKLinkFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GenerateWebSDKLinkFunc(levelName, opts)
+}
+
+func (m *Client) GetAMLChecks(id string) (r0 []sumsub.AMLCheck, err error) {
+	m.record("GetAMLChecks")
+	if m.GetAMLChecksFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetAMLChecksFunc(id)
+}
+
+func (m *Client) GetAPIUsage(from time.Time, to time.Time) (r0 []sumsub.UsageCounter, err error) {
+	m.record("GetAPIUsage")
+	if m.GetAPIUsageFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetAPIUsageFunc(from, to)
+}
+
+func (m *Client) GetApplicant(id string) (r0 sumsub.Applicant, err error) {
+	m.record("GetApplicant")
+	if m.GetApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantFunc(id)
+}
+
+func (m *Client) GetApplicantAction(actionID string) (r0 sumsub.ApplicantAction, err error) {
+	m.record("GetApplicantAction")
+	if m.GetApplicantActionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantActionFunc(actionID)
+}
+
+func (m *Client) GetApplicantActionByExternalID(externalActionID string) (r0 sumsub.ApplicantAction, err error) {
+	m.record("GetApplicantActionByExternalID")
+	if m.GetApplicantActionByExternalIDFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantActionByExternalIDFunc(externalActionID)
+}
+
+func (m *Client) GetApplicantActionStatus(actionID string) (r0 sumsub.ApplicantStatus, err error) {
+	m.record("GetApplicantActionStatus")
+	if m.GetApplicantActionStatusFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantActionStatusFunc(actionID)
+}
+
+func (m *Client) GetApplicantAgreements(id string) (r0 []sumsub.Agreement, err error) {
+	m.record("GetApplicantAgreements")
+	if m.GetApplicantAgreementsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantAgreementsFunc(id)
+}
+
+func (m *Client) GetApplicantDeletionStatus(id string) (r0 sumsub.DeletionStatus, err error) {
+	m.record("GetApplicantDeletionStatus")
+	if m.GetApplicantDeletionStatusFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantDeletionStatusFunc(id)
+}
+
+func (m *Client) GetApplicantDocuments(id string) (r0 []sumsub.DocumentResource, err error) {
+	m.record("GetApplicantDocuments")
+	if m.GetApplicantDocumentsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantDocumentsFunc(id)
+}
+
+func (m *Client) GetApplicantEvents(id string, offset int, limit int) (r0 []sumsub.ApplicantEvent, r1 int, err error) {
+	m.record("GetApplicantEvents")
+	if m.GetApplicantEventsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantEventsFunc(id, offset, limit)
+}
+
+func (m *Client) GetApplicantNotes(id string) (r0 []sumsub.Note, err error) {
+	m.record("GetApplicantNotes")
+	if m.GetApplicantNotesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantNotesFunc(id)
+}
+
+func (m *Client) GetApplicantOne(id string) (r0 sumsub.Applicant, err error) {
+	m.record("GetApplicantOne")
+	if m.GetApplicantOneFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantOneFunc(id)
+}
+
+func (m *Client) GetApplicantQuestionnaires(id string) (r0 []sumsub.Questionnaire, err error) {
+	m.record("GetApplicantQuestionnaires")
+	if m.GetApplicantQuestionnairesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantQuestionnairesFunc(id)
+}
+
+func (m *Client) GetApplicantReport(id string, reportType string, lang string) (r0 []byte, err error) {
+	m.record("GetApplicantReport")
+	if m.GetApplicantReportFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantReportFunc(id, reportType, lang)
+}
+
+func (m *Client) GetApplicantReviewHistory(id string) (r0 []sumsub.ReviewAttempt, err error) {
+	m.record("GetApplicantReviewHistory")
+	if m.GetApplicantReviewHistoryFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantReviewHistoryFunc(id)
+}
+
+func (m *Client) GetApplicantStatus(id string) (r0 sumsub.ApplicantStatus, err error) {
+	m.record("GetApplicantStatus")
+	if m.GetApplicantStatusFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantStatusFunc(id)
+}
+
+func (m *Client) GetApplicantStatuses(ctx context.Context, ids []string, concurrency int) (r0 map[string]sumsub.StatusResult) {
+	m.record("GetApplicantStatuses")
+	if m.GetApplicantStatusesFunc == nil {
+		return
+	}
+
+	return m.GetApplicantStatusesFunc(ctx, ids, concurrency)
+}
+
+func (m *Client) GetApplicantTags(id string) (r0 []string, err error) {
+	m.record("GetApplicantTags")
+	if m.GetApplicantTagsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetApplicantTagsFunc(id)
+}
+
+func (m *Client) GetAuditLog(from time.Time, to time.Time, offset int, limit int) (r0 []sumsub.AuditEvent, r1 int, err error) {
+	m.record("GetAuditLog")
+	if m.GetAuditLogFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetAuditLogFunc(from, to, offset, limit)
+}
+
+func (m *Client) GetCompanyRegistryData(id string) (r0 []sumsub.CompanyCheck, err error) {
+	m.record("GetCompanyRegistryData")
+	if m.GetCompanyRegistryDataFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetCompanyRegistryDataFunc(id)
+}
+
+func (m *Client) GetCountryDocTypes(country string) (r0 []string, err error) {
+	m.record("GetCountryDocTypes")
+	if m.GetCountryDocTypesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetCountryDocTypesFunc(country)
+}
+
+func (m *Client) GetDocumentImage(inspectionID string, imageID string) (r0 []byte, r1 string, err error) {
+	m.record("GetDocumentImage")
+	if m.GetDocumentImageFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetDocumentImageFunc(inspectionID, imageID)
+}
+
+func (m *Client) GetFaceAuthResult(session sumsub.FaceAuthSession) (r0 sumsub.ApplicantAction, err error) {
+	m.record("GetFaceAuthResult")
+	if m.GetFaceAuthResultFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetFaceAuthResultFunc(session)
+}
+
+func (m *Client) GetLatestChecks(id string, checkType string) (r0 []sumsub.Check, err error) {
+	m.record("GetLatestChecks")
+	if m.GetLatestChecksFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetLatestChecksFunc(id, checkType)
+}
+
+func (m *Client) GetLevel(name string) (r0 sumsub.Level, err error) {
+	m.record("GetLevel")
+	if m.GetLevelFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetLevelFunc(name)
+}
+
+func (m *Client) GetModerationStates(id string) (r0 []sumsub.ModerationState, err error) {
+	m.record("GetModerationStates")
+	if m.GetModerationStatesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetModerationStatesFunc(id)
+}
+
+func (m *Client) GetOnboardingState(id string) (r0 sumsub.OnboardingState, err error) {
+	m.record("GetOnboardingState")
+	if m.GetOnboardingStateFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetOnboardingStateFunc(id)
+}
+
+func (m *Client) GetOwnershipTree(id string) (r0 *sumsub.OwnershipNode, err error) {
+	m.record("GetOwnershipTree")
+	if m.GetOwnershipTreeFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetOwnershipTreeFunc(id)
+}
+
+func (m *Client) GetQuestionnaireDefinitions(levelName string) (r0 []sumsub.QuestionnaireDefinition, err error) {
+	m.record("GetQuestionnaireDefinitions")
+	if m.GetQuestionnaireDefinitionsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetQuestionnaireDefinitionsFunc(levelName)
+}
+
+func (m *Client) GetRequiredDocsStatus(id string) (r0 map[string]*sumsub.RequiredDocStatus, err error) {
+	m.record("GetRequiredDocsStatus")
+	if m.GetRequiredDocsStatusFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetRequiredDocsStatusFunc(id)
+}
+
+func (m *Client) GetSupportedDocTypes() (r0 map[string][]string, err error) {
+	m.record("GetSupportedDocTypes")
+	if m.GetSupportedDocTypesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetSupportedDocTypesFunc()
+}
+
+func (m *Client) GetTransaction(id string) (r0 sumsub.TransactionResult, err error) {
+	m.record("GetTransaction")
+	if m.GetTransactionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetTransactionFunc(id)
+}
+
+func (m *Client) GetTransactionByTxnID(txnID string) (r0 sumsub.TransactionResult, err error) {
+	m.record("GetTransactionByTxnID")
+	if m.GetTransactionByTxnIDFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetTransactionByTxnIDFunc(txnID)
+}
+
+func (m *Client) GetTravelRuleStatus(id string) (r0 string, err error) {
+	m.record("GetTravelRuleStatus")
+	if m.GetTravelRuleStatusFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetTravelRuleStatusFunc(id)
+}
+
+func (m *Client) GetWebhookAction(w *sumsub.ApplicantActionWebhook) (r0 sumsub.ApplicantAction, err error) {
+	m.record("GetWebhookAction")
+	if m.GetWebhookActionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.GetWebhookActionFunc(w)
+}
+
+func (m *Client) Health() (r0 sumsub.HealthStatus, err error) {
+	m.record("Health")
+	if m.HealthFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.HealthFunc()
+}
+
+func (m *Client) ImportApplicant(shareToken string) (r0 sumsub.Applicant, err error) {
+	m.record("ImportApplicant")
+	if m.ImportApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ImportApplicantFunc(shareToken)
+}
+
+func (m *Client) InvalidateApplicant(id string) (err error) {
+	m.record("InvalidateApplicant")
+	if m.InvalidateApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.InvalidateApplicantFunc(id)
+}
+
+func (m *Client) InviteBeneficiaries(companyID string, levelName string, opts sumsub.WebSDKLinkOptions) (r0 []sumsub.BeneficiaryInvitation, err error) {
+	m.record("InviteBeneficiaries")
+	if m.InviteBeneficiariesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.InviteBeneficiariesFunc(companyID, levelName, opts)
+}
+
+func (m *Client) LinkApplicantToCompany(companyID string, applicantID string, types []string, shareSize float64) (r0 sumsub.Beneficiary, err error) {
+	m.record("LinkApplicantToCompany")
+	if m.LinkApplicantToCompanyFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.LinkApplicantToCompanyFunc(companyID, applicantID, types, shareSize)
+}
+
+func (m *Client) ListApplicantActions(id string, offset int, limit int) (r0 []sumsub.ApplicantAction, r1 int, err error) {
+	m.record("ListApplicantActions")
+	if m.ListApplicantActionsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ListApplicantActionsFunc(id, offset, limit)
+}
+
+func (m *Client) ListApplicants(offset int, limit int) (r0 []sumsub.Applicant, r1 int, err error) {
+	m.record("ListApplicants")
+	if m.ListApplicantsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ListApplicantsFunc(offset, limit)
+}
+
+func (m *Client) ListLevels() (r0 []sumsub.Level, err error) {
+	m.record("ListLevels")
+	if m.ListLevelsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ListLevelsFunc()
+}
+
+func (m *Client) OutstandingBeneficiaries(companyID string) (r0 []sumsub.Beneficiary, err error) {
+	m.record("OutstandingBeneficiaries")
+	if m.OutstandingBeneficiariesFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.OutstandingBeneficiariesFunc(companyID)
+}
+
+func (m *Client) Ping() (err error) {
+	m.record("Ping")
+	if m.PingFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.PingFunc()
+}
+
+func (m *Client) RefreshAccessToken(token sumsub.AccessToken) (r0 sumsub.AccessToken, err error) {
+	m.record("RefreshAccessToken")
+	if m.RefreshAccessTokenFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.RefreshAccessTokenFunc(token)
+}
+
+func (m *Client) RemoveApplicantTags(id string, tags ...string) (err error) {
+	m.record("RemoveApplicantTags")
+	if m.RemoveApplicantTagsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.RemoveApplicantTagsFunc(id, tags...)
+}
+
+func (m *Client) RemoveBeneficiary(id string, beneficiaryID string) (err error) {
+	m.record("RemoveBeneficiary")
+	if m.RemoveBeneficiaryFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.RemoveBeneficiaryFunc(id, beneficiaryID)
+}
+
+func (m *Client) RequestActionCheck(actionID string) (err error) {
+	m.record("RequestActionCheck")
+	if m.RequestActionCheckFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.RequestActionCheckFunc(actionID)
+}
+
+func (m *Client) RequestApplicantDeletion(id string) (r0 sumsub.DeletionStatus, err error) {
+	m.record("RequestApplicantDeletion")
+	if m.RequestApplicantDeletionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.RequestApplicantDeletionFunc(id)
+}
+
+func (m *Client) RescoreTransaction(id string) (r0 sumsub.TransactionResult, err error) {
+	m.record("RescoreTransaction")
+	if m.RescoreTransactionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.RescoreTransactionFunc(id)
+}
+
+func (m *Client) ResendWebhook(id string) (err error) {
+	m.record("ResendWebhook")
+	if m.ResendWebhookFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ResendWebhookFunc(id)
+}
+
+func (m *Client) ResolveAMLHit(checkID string, hitID string, resolution string, comment string) (err error) {
+	m.record("ResolveAMLHit")
+	if m.ResolveAMLHitFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ResolveAMLHitFunc(checkID, hitID, resolution, comment)
+}
+
+func (m *Client) ReviewReport(from time.Time, to time.Time) (r0 *sumsub.ReviewReport, err error) {
+	m.record("ReviewReport")
+	if m.ReviewReportFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.ReviewReportFunc(from, to)
+}
+
+func (m *Client) RotateDocumentImage(inspectionID string, imageID string, angle int) (err error) {
+	m.record("RotateDocumentImage")
+	if m.RotateDocumentImageFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.RotateDocumentImageFunc(inspectionID, imageID, angle)
+}
+
+func (m *Client) SendConfirmationCode(id string, contact string) (err error) {
+	m.record("SendConfirmationCode")
+	if m.SendConfirmationCodeFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SendConfirmationCodeFunc(id, contact)
+}
+
+func (m *Client) SendTestWebhook(data sumsub.TestWebhookRequest) (err error) {
+	m.record("SendTestWebhook")
+	if m.SendTestWebhookFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SendTestWebhookFunc(data)
+}
+
+func (m *Client) SetApplicantPriority(id string, priority int) (err error) {
+	m.record("SetApplicantPriority")
+	if m.SetApplicantPriorityFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SetApplicantPriorityFunc(id, priority)
+}
+
+func (m *Client) SetApplicantTags(id string, tags []string) (err error) {
+	m.record("SetApplicantTags")
+	if m.SetApplicantTagsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SetApplicantTagsFunc(id, tags)
+}
+
+func (m *Client) SetOngoingMonitoring(id string, enabled bool) (err error) {
+	m.record("SetOngoingMonitoring")
+	if m.SetOngoingMonitoringFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SetOngoingMonitoringFunc(id, enabled)
+}
+
+func (m *Client) SetTransactionTags(id string, tags []string) (err error) {
+	m.record("SetTransactionTags")
+	if m.SetTransactionTagsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SetTransactionTagsFunc(id, tags)
+}
+
+func (m *Client) SimulateApproval(id string) (err error) {
+	m.record("SimulateApproval")
+	if m.SimulateApprovalFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SimulateApprovalFunc(id)
+}
+
+func (m *Client) SimulateFinalRejection(id string, comment string, labels ...string) (err error) {
+	m.record("SimulateFinalRejection")
+	if m.SimulateFinalRejectionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SimulateFinalRejectionFunc(id, comment, labels...)
+}
+
+func (m *Client) SimulateImageReviews(id string, images map[string]sumsub.ImageReviewResult) (err error) {
+	m.record("SimulateImageReviews")
+	if m.SimulateImageReviewsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SimulateImageReviewsFunc(id, images)
+}
+
+func (m *Client) SimulateRetry(id string, comment string, labels ...string) (err error) {
+	m.record("SimulateRetry")
+	if m.SimulateRetryFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SimulateRetryFunc(id, comment, labels...)
+}
+
+func (m *Client) SubmitQuestionnaire(id string, q sumsub.Questionnaire) (err error) {
+	m.record("SubmitQuestionnaire")
+	if m.SubmitQuestionnaireFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SubmitQuestionnaireFunc(id, q)
+}
+
+func (m *Client) SubmitTransaction(id string, txn sumsub.Transaction) (r0 sumsub.TransactionResult, err error) {
+	m.record("SubmitTransaction")
+	if m.SubmitTransactionFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SubmitTransactionFunc(id, txn)
+}
+
+func (m *Client) SubmitTravelRuleTransfer(id string, t sumsub.TravelRuleTransfer) (r0 sumsub.TransactionResult, err error) {
+	m.record("SubmitTravelRuleTransfer")
+	if m.SubmitTravelRuleTransferFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.SubmitTravelRuleTransferFunc(id, t)
+}
+
+func (m *Client) UpdateBeneficiary(id string, b sumsub.Beneficiary) (r0 sumsub.Beneficiary, err error) {
+	m.record("UpdateBeneficiary")
+	if m.UpdateBeneficiaryFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.UpdateBeneficiaryFunc(id, b)
+}
+
+func (m *Client) UpdateCompanyInfo(id string, info sumsub.CompanyInfo) (r0 sumsub.CompanyInfo, err error) {
+	m.record("UpdateCompanyInfo")
+	if m.UpdateCompanyInfoFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.UpdateCompanyInfoFunc(id, info)
+}
+
+func (m *Client) UpdateFixedInfo(id string, info sumsub.ApplicantInfo) (r0 sumsub.ApplicantInfo, err error) {
+	m.record("UpdateFixedInfo")
+	if m.UpdateFixedInfoFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.UpdateFixedInfoFunc(id, info)
+}
+
+func (m *Client) UpdateTransactionProps(id string, props map[string]string) (err error) {
+	m.record("UpdateTransactionProps")
+	if m.UpdateTransactionPropsFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.UpdateTransactionPropsFunc(id, props)
+}
+
+func (m *Client) UploadActionDocument(actionID string, doc sumsub.Document) (r0 sumsub.DocumentResult, err error) {
+	m.record("UploadActionDocument")
+	if m.UploadActionDocumentFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.UploadActionDocumentFunc(actionID, doc)
+}
+
+func (m *Client) UploadDocument(id string, doc sumsub.Document) (r0 sumsub.DocumentResult, err error) {
+	m.record("UploadDocument")
+	if m.UploadDocumentFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.UploadDocumentFunc(id, doc)
+}
+
+func (m *Client) VerifyBankCard(id string, levelName string, externalActionID string, card sumsub.BankCard) (r0 sumsub.BankCardVerification, err error) {
+	m.record("VerifyBankCard")
+	if m.VerifyBankCardFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.VerifyBankCardFunc(id, levelName, externalActionID, card)
+}
+
+func (m *Client) WaitForReview(ctx context.Context, id string, opts sumsub.PollOptions) (r0 sumsub.ApplicantStatus, err error) {
+	m.record("WaitForReview")
+	if m.WaitForReviewFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.WaitForReviewFunc(ctx, id, opts)
+}
+
+func (m *Client) WalkAuditLog(from time.Time, to time.Time, fn func(sumsub.AuditEvent) error) (err error) {
+	m.record("WalkAuditLog")
+	if m.WalkAuditLogFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.WalkAuditLogFunc(from, to, fn)
+}
+
+func (m *Client) WhitelistApplicant(id string, note string) (err error) {
+	m.record("WhitelistApplicant")
+	if m.WhitelistApplicantFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.WhitelistApplicantFunc(id, note)
+}
+
+func (m *Client) WriteApplicantReport(w io.Writer, id string, reportType string, lang string) (err error) {
+	m.record("WriteApplicantReport")
+	if m.WriteApplicantReportFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.WriteApplicantReportFunc(w, id, reportType, lang)
+}
+
+func (m *Client) WriteDocumentImage(w io.Writer, inspectionID string, imageID string) (r0 string, err error) {
+	m.record("WriteDocumentImage")
+	if m.WriteDocumentImageFunc == nil {
+		err = ErrNotImplemented
+		return
+	}
+
+	return m.WriteDocumentImageFunc(w, inspectionID, imageID)
+}
@@ -0,0 +1,42 @@
+package sumsubmock
+
+import (
+	"testing"
+
+	"github.com/sg3des/sumsub"
+)
+
+// approve is example of the code depending on sumsub.API
+func approve(api sumsub.API, id string) (bool, error) {
+	status, err := api.GetApplicantStatus(id)
+	if err != nil {
+		return false, err
+	}
+
+	_, pass := status.IsPass()
+	return status.IsCompleted() && pass, nil
+}
+
+func TestClient(t *testing.T) {
+	m := &Client{
+		GetApplicantStatusFunc: func(id string) (sumsub.ApplicantStatus, error) {
+			return sumsub.ApplicantStatus{
+				ApplicantID:  id,
+				ReviewStatus: sumsub.ReviewStatusCompleted,
+				ReviewResult: sumsub.ReviewResult{ReviewAnswer: sumsub.ReviewResultGREEN},
+			}, nil
+		},
+	}
+
+	if ok, err := approve(m, "id"); err != nil || !ok {
+		t.Error("applicant should be approved", ok, err)
+	}
+
+	if err := m.DeactivateApplicant("id"); err != ErrNotImplemented {
+		t.Error("expected ErrNotImplemented, got", err)
+	}
+
+	if calls := m.Calls(); len(calls) != 2 || calls[0] != "GetApplicantStatus" {
+		t.Error("wrong calls", calls)
+	}
+}
@@ -0,0 +1,79 @@
+// Package sumsubotel adds sumsub api requests to opentelemetry traces
+//
+//	client, err := sumsub.NewClient(addr, user, pass, sumsub.WithTracer(sumsubotel.New(nil)))
+//
+// Context passed to client.Do and client.WaitForReview becomes parent of the
+// request spans, trace context is propagated to sumsub in request headers.
+package sumsubotel
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sg3des/sumsub"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is name of the tracer
+const instrumentationName = "github.com/sg3des/sumsub"
+
+// Tracer implements sumsub.Tracer with opentelemetry spans
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+var _ sumsub.Tracer = (*Tracer)(nil)
+
+// New creates tracer with spans from the provider, global provider and
+// propagator are used if tp is nil
+func New(tp trace.TracerProvider) *Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	return &Tracer{
+		tracer:     tp.Tracer(instrumentationName),
+		propagator: otel.GetTextMapPropagator(),
+	}
+}
+
+func (t *Tracer) StartSpan(ctx context.Context, s sumsub.Span) (http.Header, func(sumsub.SpanResult)) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.method", s.Method),
+		attribute.String("sumsub.endpoint", s.Endpoint),
+	}
+	if s.ApplicantHash != "" {
+		attrs = append(attrs, attribute.String("sumsub.applicant_hash", s.ApplicantHash))
+	}
+
+	ctx, span := t.tracer.Start(ctx, "sumsub "+s.Method+" "+s.Endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	header := make(http.Header)
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(header))
+
+	return header, func(result sumsub.SpanResult) {
+		defer span.End()
+
+		if result.StatusCode != 0 {
+			span.SetAttributes(attribute.Int("http.status_code", result.StatusCode))
+		}
+		if result.CorrelationID != "" {
+			span.SetAttributes(attribute.String("sumsub.correlation_id", result.CorrelationID))
+		}
+
+		if result.Err != nil {
+			span.RecordError(result.Err)
+			span.SetStatus(codes.Error, result.Err.Error())
+		} else if result.StatusCode >= 400 {
+			span.SetStatus(codes.Error, http.StatusText(result.StatusCode))
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Package sumsubprom collects prometheus metrics of the sumsub client
+//
+//	metrics, err := sumsubprom.New(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client, err := sumsub.NewClient(addr, user, pass, sumsub.WithObserver(metrics))
+//
+// The package requires github.com/prometheus/client_golang v1.11.0 or newer,
+// it is not imported by the sumsub package itself.
+package sumsubprom
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sg3des/sumsub"
+)
+
+// Metrics implements sumsub.Observer with prometheus collectors
+type Metrics struct {
+	requests       *prometheus.CounterVec
+	duration       *prometheus.HistogramVec
+	tokenRefreshes *prometheus.CounterVec
+	uploadBytes    prometheus.Counter
+	retries        *prometheus.CounterVec
+	rateLimitWait  *prometheus.HistogramVec
+}
+
+var _ sumsub.Observer = (*Metrics)(nil)
+
+// New creates metrics and registers them in reg
+func New(reg prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sumsub",
+			Name:      "requests_total",
+			Help:      "Count of the sumsub api requests by endpoint and status code, status 0 means request failed without response.",
+		}, []string{"method", "endpoint", "status"}),
+
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "sumsub",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the sumsub api requests by endpoint and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "endpoint", "status"}),
+
+		tokenRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sumsub",
+			Name:      "token_refreshes_total",
+			Help:      "Count of the attempts to obtain api token by result.",
+		}, []string{"result"}),
+
+		uploadBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "sumsub",
+			Name:      "upload_bytes_total",
+			Help:      "Size of the uploaded documents content.",
+		}),
+
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sumsub",
+			Name:      "retries_total",
+			Help:      "Count of the repeated sumsub api requests by endpoint and status code of the rejected attempt.",
+		}, []string{"method", "endpoint", "status"}),
+
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "sumsub",
+			Name:      "rate_limit_wait_seconds",
+			Help:      "Delay of the sumsub api requests by the client rate limit.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "endpoint"}),
+	}
+
+	for _, c := range []prometheus.Collector{m.requests, m.duration, m.tokenRefreshes, m.uploadBytes, m.retries, m.rateLimitWait} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Metrics) ObserveRequest(method, endpoint string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	m.requests.WithLabelValues(method, endpoint, code).Inc()
+	m.duration.WithLabelValues(method, endpoint, code).Observe(duration.Seconds())
+}
+
+func (m *Metrics) ObserveTokenRefresh(err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+
+	m.tokenRefreshes.WithLabelValues(result).Inc()
+}
+
+func (m *Metrics) ObserveUpload(bytes int64) {
+	m.uploadBytes.Add(float64(bytes))
+}
+
+func (m *Metrics) ObserveRetry(method, endpoint string, status int) {
+	m.retries.WithLabelValues(method, endpoint, strconv.Itoa(status)).Inc()
+}
+
+func (m *Metrics) ObserveRateLimitWait(method, endpoint string, wait time.Duration) {
+	m.rateLimitWait.WithLabelValues(method, endpoint).Observe(wait.Seconds())
+}
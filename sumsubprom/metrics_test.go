@@ -0,0 +1,47 @@
+package sumsubprom
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	m, err := New(prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const endpoint = "/resources/applicants/{id}/status"
+	m.ObserveRequest("GET", endpoint, 429, time.Second)
+	m.ObserveRequest("GET", endpoint, 200, time.Second)
+	m.ObserveRetry("GET", endpoint, 429)
+	m.ObserveRateLimitWait("GET", endpoint, 100*time.Millisecond)
+	m.ObserveTokenRefresh(nil)
+	m.ObserveTokenRefresh(errors.New("unauthorized"))
+	m.ObserveUpload(1024)
+
+	counters := map[string]prometheus.Collector{
+		"requests":      m.requests.WithLabelValues("GET", endpoint, "200"),
+		"retries":       m.retries.WithLabelValues("GET", endpoint, "429"),
+		"token refresh": m.tokenRefreshes.WithLabelValues("error"),
+	}
+	for name, c := range counters {
+		if v := testutil.ToFloat64(c); v != 1 {
+			t.Errorf("%s: expected 1, got %v", name, v)
+		}
+	}
+
+	if v := testutil.ToFloat64(m.uploadBytes); v != 1024 {
+		t.Error("wrong upload bytes", v)
+	}
+	if n := testutil.CollectAndCount(m.duration); n != 2 {
+		t.Error("wrong count of duration series", n)
+	}
+	if n := testutil.CollectAndCount(m.rateLimitWait); n != 1 {
+		t.Error("wrong count of rate limit wait series", n)
+	}
+}
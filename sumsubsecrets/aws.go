@@ -0,0 +1,178 @@
+package sumsubsecrets
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sg3des/sumsub"
+)
+
+// AWSSecretsManager reads credentials from the secret of AWS Secrets Manager,
+// secret string should be json object with user and password keys
+type AWSSecretsManager struct {
+	// Region of the secret, AWS_REGION is used if empty
+	Region string
+
+	// SecretID is name or arn of the secret
+	SecretID string
+
+	// access keys, AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+	// AWS_SESSION_TOKEN are used if AccessKeyID is empty
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	// UserKey and PassKey are keys of the secret, DefaultUserKey and
+	// DefaultPassKey are used if empty
+	UserKey string
+	PassKey string
+
+	// Endpoint of the service, https://secretsmanager.{region}.amazonaws.com
+	// is used if empty
+	Endpoint string
+
+	// Client sends requests to aws, client with 10s timeout is used if nil
+	Client *http.Client
+}
+
+var _ sumsub.CredentialsProvider = (*AWSSecretsManager)(nil)
+
+// Credentials reads the secret with GetSecretValue action
+func (a *AWSSecretsManager) Credentials() (string, string, error) {
+	region := a.Region
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	keyID, secretKey, sessionToken := a.AccessKeyID, a.SecretAccessKey, a.SessionToken
+	if keyID == "" {
+		keyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		secretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if region == "" || a.SecretID == "" || keyID == "" || secretKey == "" {
+		return "", "", errors.New("aws region, secret id and access keys are required")
+	}
+
+	endpoint := a.Endpoint
+	if endpoint == "" {
+		endpoint = "https://secretsmanager." + region + ".amazonaws.com"
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": a.SecretID})
+	if err != nil {
+		return "", "", err
+	}
+
+	r, err := http.NewRequest("POST", strings.TrimRight(endpoint, "/")+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", "", err
+	}
+	r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	r.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	if sessionToken != "" {
+		r.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+	signV4(r, body, keyID, secretKey, region, "secretsmanager", time.Now())
+
+	resp, err := httpClient(a.Client).Do(r)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+	if resp.StatusCode != 200 {
+		return "", "", fmt.Errorf("aws secret %s: %s %s", a.SecretID, resp.Status, data)
+	}
+
+	var secret struct {
+		SecretString string
+	}
+	if err := json.Unmarshal(data, &secret); err != nil {
+		return "", "", err
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(secret.SecretString), &values); err != nil {
+		return "", "", fmt.Errorf("aws secret %s is not json object: %v", a.SecretID, err)
+	}
+
+	return credentials(values, a.UserKey, a.PassKey)
+}
+
+// signV4 signs request with AWS Signature Version 4, all headers of the
+// request and host are signed, query of the url should be already encoded
+// in canonical order
+func signV4(r *http.Request, body []byte, keyID, secretKey, region, service string, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	r.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": r.URL.Host}
+	for k, v := range r.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := r.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		path,
+		r.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hexSHA256([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+keyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
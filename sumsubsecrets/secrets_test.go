@@ -0,0 +1,101 @@
+package sumsubsecrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/sumsub":
+			fmt.Fprint(w, `{"data": {"data": {"user": "user2", "pass": "pass2"}, "metadata": {"version": 2}}}`)
+		case "/v1/kv/sumsub":
+			fmt.Fprint(w, `{"data": {"login": "user1", "password": "pass1"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	v := &Vault{Addr: srv.URL, Token: "token", Path: "secret/data/sumsub"}
+	if user, pass, err := v.Credentials(); err != nil || user != "user2" || pass != "pass2" {
+		t.Error("wrong credentials of kv v2 secret", user, pass, err)
+	}
+
+	v = &Vault{Addr: srv.URL, Token: "token", Path: "kv/sumsub", UserKey: "login", PassKey: "password"}
+	if user, pass, err := v.Credentials(); err != nil || user != "user1" || pass != "pass1" {
+		t.Error("wrong credentials of kv v1 secret", user, pass, err)
+	}
+
+	v = &Vault{Addr: srv.URL, Token: "token", Path: "kv/sumsub"}
+	if _, _, err := v.Credentials(); err == nil {
+		t.Error("expected error for missing keys")
+	}
+
+	v = &Vault{Addr: srv.URL, Token: "wrong", Path: "kv/sumsub"}
+	if _, _, err := v.Credentials(); err == nil {
+		t.Error("expected error for forbidden request")
+	}
+}
+
+func TestAWSSecretsManager(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(auth, "/eu-west-1/secretsmanager/aws4_request") ||
+			r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" || r.Header.Get("X-Amz-Security-Token") != "session" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		var input struct{ SecretId string }
+		json.NewDecoder(r.Body).Decode(&input)
+		if input.SecretId != "prod/sumsub" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"__type": "ResourceNotFoundException"}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"Name": "prod/sumsub", "SecretString": "{\"user\": \"user\", \"pass\": \"pass\"}"}`)
+	}))
+	defer srv.Close()
+
+	a := &AWSSecretsManager{
+		Region:          "eu-west-1",
+		SecretID:        "prod/sumsub",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		SessionToken:    "session",
+		Endpoint:        srv.URL,
+	}
+	if user, pass, err := a.Credentials(); err != nil || user != "user" || pass != "pass" {
+		t.Error("wrong credentials", user, pass, err)
+	}
+
+	a.SecretID = "unknown"
+	if _, _, err := a.Credentials(); err == nil || !strings.Contains(err.Error(), "ResourceNotFoundException") {
+		t.Error("expected api error, got", err)
+	}
+}
+
+// TestSignV4 checks signature with get-vanilla case of the aws signature v4
+// test suite
+func TestSignV4(t *testing.T) {
+	r, _ := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+	signV4(r, nil, "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	expected := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if auth := r.Header.Get("Authorization"); auth != expected {
+		t.Error("wrong signature", auth)
+	}
+}
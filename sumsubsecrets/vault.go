@@ -0,0 +1,127 @@
+// Package sumsubsecrets provides sumsub.CredentialsProvider implementations
+// reading api credentials from HashiCorp Vault and AWS Secrets Manager, the
+// secret is requested each time the client obtains token, so rotated
+// credentials are used without restart
+//
+//	creds := &sumsubsecrets.Vault{Path: "secret/data/sumsub"}
+//	client, err := sumsub.NewClientWithCredentials(sumsub.Addr, creds)
+//
+// Providers call http api of the services directly, SDKs are not required.
+package sumsubsecrets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sg3des/sumsub"
+)
+
+// default keys of the user and password in the secret
+const (
+	DefaultUserKey = "user"
+	DefaultPassKey = "pass"
+)
+
+// Vault reads credentials from the key/value secret of HashiCorp Vault, both
+// versions of the kv engine are supported
+type Vault struct {
+	// Addr of the vault server, VAULT_ADDR is used if empty
+	Addr string
+
+	// Token to access vault, VAULT_TOKEN is used if empty
+	Token string
+
+	// Path of the secret, for kv version 2 it includes data segment, e.g.
+	// secret/data/sumsub
+	Path string
+
+	// UserKey and PassKey are keys of the secret, DefaultUserKey and
+	// DefaultPassKey are used if empty
+	UserKey string
+	PassKey string
+
+	// Client sends requests to vault, client with 10s timeout is used if nil
+	Client *http.Client
+}
+
+var _ sumsub.CredentialsProvider = (*Vault)(nil)
+
+// Credentials reads the secret
+// GET /v1/{path}
+func (v *Vault) Credentials() (string, string, error) {
+	addr, token := v.Addr, v.Token
+	if addr == "" {
+		addr = os.Getenv("VAULT_ADDR")
+	}
+	if token == "" {
+		token = os.Getenv("VAULT_TOKEN")
+	}
+	if addr == "" || v.Path == "" {
+		return "", "", errors.New("vault address and secret path are required")
+	}
+
+	r, err := http.NewRequest("GET", strings.TrimRight(addr, "/")+"/v1/"+strings.Trim(v.Path, "/"), nil)
+	if err != nil {
+		return "", "", err
+	}
+	r.Header.Set("X-Vault-Token", token)
+
+	resp, err := httpClient(v.Client).Do(r)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", "", fmt.Errorf("vault secret %s: %s", v.Path, resp.Status)
+	}
+
+	var secret struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", "", err
+	}
+
+	// kv version 2 keeps values in data.data
+	values := secret.Data
+	if data, ok := secret.Data["data"]; ok {
+		values = nil
+		if err := json.Unmarshal(data, &values); err != nil {
+			return "", "", fmt.Errorf("vault secret %s: %v", v.Path, err)
+		}
+	}
+
+	return credentials(values, v.UserKey, v.PassKey)
+}
+
+// credentials returns values of the user and password keys
+func credentials(values map[string]json.RawMessage, userKey, passKey string) (user, pass string, err error) {
+	if userKey == "" {
+		userKey = DefaultUserKey
+	}
+	if passKey == "" {
+		passKey = DefaultPassKey
+	}
+
+	json.Unmarshal(values[userKey], &user)
+	json.Unmarshal(values[passKey], &pass)
+	if user == "" || pass == "" {
+		return "", "", fmt.Errorf("secret keys %s and %s are not found", userKey, passKey)
+	}
+
+	return user, pass, nil
+}
+
+func httpClient(c *http.Client) *http.Client {
+	if c != nil {
+		return c
+	}
+
+	return &http.Client{Timeout: 10 * time.Second}
+}
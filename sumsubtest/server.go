@@ -0,0 +1,398 @@
+// Package sumsubtest provides in-process fake sumsub server for tests, it
+// keeps applicants in memory and implements authentication, applicant
+// creation, document upload, status, review simulation and webhooks
+package sumsubtest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sg3des/sumsub"
+)
+
+// Server is fake sumsub api
+type Server struct {
+	*httptest.Server
+
+	User string
+	Pass string
+
+	mu         sync.Mutex
+	token      string
+	applicants map[string]*applicant
+
+	webhookURL    string
+	webhookSecret string
+}
+
+// applicant is state of the applicant kept by server
+type applicant struct {
+	sumsub.Applicant
+	documents []sumsub.DocumentResult
+	webhook   []byte
+}
+
+// NewServer starts fake server which accepts credentials user and pass,
+// server should be closed after test
+func NewServer(user, pass string) *Server {
+	s := &Server{
+		User:       user,
+		Pass:       pass,
+		token:      randomID(),
+		applicants: make(map[string]*applicant),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+
+	return s
+}
+
+// Client creates sumsub client connected to the server
+func (s *Server) Client(opts ...sumsub.Option) (*sumsub.SumSub, error) {
+	return sumsub.NewClient(s.URL, s.User, s.Pass, opts...)
+}
+
+// SetWebhook makes server send webhooks to the url signed with secret,
+// webhooks are sent synchronously when applicant state changes
+func (s *Server) SetWebhook(url, secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.webhookURL = url
+	s.webhookSecret = secret
+}
+
+// Applicant returns applicant stored by server
+func (s *Server) Applicant(id string) (sumsub.Applicant, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.applicants[id]
+	if !ok {
+		return sumsub.Applicant{}, false
+	}
+
+	return a.Applicant, true
+}
+
+// Documents returns documents uploaded to the applicant
+func (s *Server) Documents(id string) []sumsub.DocumentResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if a, ok := s.applicants[id]; ok {
+		return append([]sumsub.DocumentResult(nil), a.documents...)
+	}
+
+	return nil
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+
+	if path == "resources/auth/login" {
+		s.login(w, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+s.token {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	switch {
+	case r.Method == "POST" && path == "resources/applicants":
+		s.createApplicant(w, r)
+	case r.Method == "POST" && path == "resources/webhooks/test":
+		s.testWebhook(w, r)
+	case len(parts) >= 3 && parts[0] == "resources" && parts[1] == "applicants":
+		s.mu.Lock()
+		a, ok := s.applicants[parts[2]]
+		s.mu.Unlock()
+		if !ok {
+			writeError(w, http.StatusNotFound, "applicant not found")
+			return
+		}
+
+		s.applicant(w, r, a, strings.Join(parts[3:], "/"))
+	default:
+		writeError(w, http.StatusNotFound, "unknown endpoint "+r.Method+" "+path)
+	}
+}
+
+func (s *Server) applicant(w http.ResponseWriter, r *http.Request, a *applicant, path string) {
+	switch r.Method + " " + path {
+	case "GET ":
+		s.mu.Lock()
+		list := map[string]interface{}{
+			"list": map[string]interface{}{"items": []sumsub.Applicant{a.Applicant}, "totalItems": 1},
+		}
+		s.mu.Unlock()
+		writeJSON(w, list)
+	case "GET one":
+		s.mu.Lock()
+		data := a.Applicant
+		s.mu.Unlock()
+		writeJSON(w, data)
+	case "GET status":
+		writeJSON(w, s.status(a))
+	case "POST info/idDoc":
+		s.uploadDocument(w, r, a)
+	case "POST status/pending":
+		s.setReview(a, sumsub.ReviewStatusPending, sumsub.ReviewResult{})
+		s.sendWebhook(a, sumsub.WebhookApplicantPending)
+		writeJSON(w, map[string]string{"ok": "1"})
+	case "POST status/testCompleted":
+		var data sumsub.ApplicantCompleteRequest
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		s.setReview(a, sumsub.ReviewStatusCompleted, sumsub.ReviewResult{
+			ReviewAnswer:      data.ReviewAnswer,
+			RejectLabels:      data.RejectLabels,
+			ReviewRejectType:  data.ReviewRejectType,
+			ModerationComment: data.ModerationComment,
+			ClientComment:     data.ClientComment,
+		})
+		s.sendWebhook(a, sumsub.WebhookApplicantReviewed)
+		writeJSON(w, map[string]string{"ok": "1"})
+	case "POST status/resendWebhook":
+		s.mu.Lock()
+		payload := a.webhook
+		s.mu.Unlock()
+		if payload == nil {
+			writeError(w, http.StatusBadRequest, "applicant is not reviewed")
+			return
+		}
+
+		s.post(a, payload)
+		writeJSON(w, map[string]string{"ok": "1"})
+	default:
+		writeError(w, http.StatusNotFound, "unknown endpoint "+r.Method+" "+path)
+	}
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	basic := base64.StdEncoding.EncodeToString([]byte(s.User + ":" + s.Pass))
+	if r.Method != "POST" || r.Header.Get("Authorization") != "Basic "+basic {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	writeJSON(w, map[string]string{"status": "ok", "payload": s.token})
+}
+
+func (s *Server) createApplicant(w http.ResponseWriter, r *http.Request) {
+	var a sumsub.Applicant
+	if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	for _, existing := range s.applicants {
+		if a.ExternalUserID != "" && existing.ExternalUserID == a.ExternalUserID {
+			s.mu.Unlock()
+			writeError(w, http.StatusConflict, "applicant with external user id "+a.ExternalUserID+" already exists")
+			return
+		}
+	}
+
+	a.ID = randomID()
+	a.InspectionID = randomID()
+	a.CreatedAt = sumsub.Time{Time: time.Now().UTC().Truncate(time.Second)}
+	a.Review = sumsub.ApplicantReview{
+		CreateDate:   a.CreatedAt,
+		ReviewStatus: sumsub.ReviewStatusInit,
+	}
+
+	stored := &applicant{Applicant: a}
+	s.applicants[a.ID] = stored
+	s.mu.Unlock()
+
+	s.sendWebhook(stored, sumsub.WebhookApplicantCreated)
+	writeJSON(w, a)
+}
+
+func (s *Server) uploadDocument(w http.ResponseWriter, r *http.Request, a *applicant) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var doc sumsub.DocumentResult
+	if err := json.Unmarshal([]byte(r.FormValue("metadata")), &doc.DocumentMetaData); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid metadata: "+err.Error())
+		return
+	}
+
+	f, _, err := r.FormFile("content")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "content is missing")
+		return
+	}
+	defer f.Close()
+
+	if data, err := ioutil.ReadAll(f); err != nil || len(data) == 0 {
+		writeError(w, http.StatusBadRequest, "content is empty")
+		return
+	}
+
+	doc.ImageID = randomID()
+
+	s.mu.Lock()
+	a.documents = append(a.documents, doc)
+	s.mu.Unlock()
+
+	w.Header().Set("X-Image-Id", doc.ImageID)
+	writeJSON(w, doc)
+}
+
+func (s *Server) testWebhook(w http.ResponseWriter, r *http.Request) {
+	var data sumsub.TestWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a := &applicant{Applicant: sumsub.Applicant{ID: data.ApplicantID}}
+	if data.ApplicantID != "" {
+		s.mu.Lock()
+		existing, ok := s.applicants[data.ApplicantID]
+		s.mu.Unlock()
+		if !ok {
+			writeError(w, http.StatusNotFound, "applicant not found")
+			return
+		}
+		a = existing
+	}
+
+	if data.Type == sumsub.WebhookApplicantReviewed && data.ReviewAnswer != "" {
+		s.setReview(a, sumsub.ReviewStatusCompleted, sumsub.ReviewResult{ReviewAnswer: data.ReviewAnswer})
+	}
+
+	s.sendWebhook(a, data.Type)
+	writeJSON(w, map[string]string{"ok": "1"})
+}
+
+func (s *Server) status(a *applicant) sumsub.ApplicantStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sumsub.ApplicantStatus{
+		ID:           a.ID,
+		InspectionID: a.InspectionID,
+		ApplicantID:  a.ID,
+		CreateDate:   a.Review.CreateDate,
+		ReviewResult: a.Review.ReviewResult,
+		ReviewStatus: a.Review.ReviewStatus,
+
+		NotificationFailureCnt: a.Review.NotificationFailureCnt,
+	}
+}
+
+func (s *Server) setReview(a *applicant, status string, result sumsub.ReviewResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a.Review.ReviewStatus = status
+	a.Review.ReviewResult = result
+	if status == sumsub.ReviewStatusCompleted {
+		a.Review.ReviewDate = sumsub.Time{Time: time.Now().UTC().Truncate(time.Second)}
+		a.Review.AttemptCnt++
+	}
+}
+
+// sendWebhook of the type to the configured url
+func (s *Server) sendWebhook(a *applicant, webhookType string) {
+	s.mu.Lock()
+	payload := map[string]interface{}{
+		"type":           webhookType,
+		"applicantId":    a.ID,
+		"inspectionId":   a.InspectionID,
+		"correlationId":  "req-" + randomID(),
+		"externalUserId": a.ExternalUserID,
+		"sandboxMode":    true,
+		"reviewStatus":   a.Review.ReviewStatus,
+		"createdAtMs":    time.Now().UTC().Format("2006-01-02 15:04:05.000"),
+	}
+	if webhookType == sumsub.WebhookApplicantReviewed {
+		payload["reviewResult"] = a.Review.ReviewResult
+	}
+
+	data, _ := json.Marshal(payload)
+	if webhookType == sumsub.WebhookApplicantReviewed {
+		a.webhook = data
+	}
+	s.mu.Unlock()
+
+	s.post(a, data)
+}
+
+// post webhook payload signed with secret, failures are counted in the
+// applicant review
+func (s *Server) post(a *applicant, payload []byte) {
+	s.mu.Lock()
+	url, secret := s.webhookURL, s.webhookSecret
+	s.mu.Unlock()
+
+	if url == "" {
+		return
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	r, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Payload-Digest", hex.EncodeToString(mac.Sum(nil)))
+	r.Header.Set("X-Payload-Digest-Alg", sumsub.DigestHMACSHA256)
+
+	resp, err := http.DefaultClient.Do(r)
+	if err == nil {
+		resp.Body.Close()
+	}
+
+	if err != nil || resp.StatusCode != http.StatusOK {
+		s.mu.Lock()
+		a.Review.NotificationFailureCnt++
+		s.mu.Unlock()
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"description":   description,
+		"code":          code,
+		"correlationId": "req-" + randomID(),
+	})
+}
+
+func randomID() string {
+	b := make([]byte, 12)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
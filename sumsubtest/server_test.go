@@ -0,0 +1,88 @@
+package sumsubtest
+
+import (
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/sg3des/sumsub"
+)
+
+func TestServer(t *testing.T) {
+	srv := NewServer("user", "pass")
+	defer srv.Close()
+
+	reviewed := make(chan *sumsub.ApplicantReviewedWebhook, 1)
+	h := sumsub.NewWebhookHandler("secret")
+	h.OnApplicantReviewed(func(w *sumsub.ApplicantReviewedWebhook) error {
+		reviewed <- w
+		return nil
+	})
+	hooks := httptest.NewServer(h)
+	defer hooks.Close()
+	srv.SetWebhook(hooks.URL, "secret")
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &sumsub.Applicant{ExternalUserID: "user-1"}
+	if err := client.CreateApplicant(a); err != nil {
+		t.Fatal(err)
+	}
+	if a.ID == "" {
+		t.Fatal("applicant id is not assigned")
+	}
+	if err := client.CreateApplicant(&sumsub.Applicant{ExternalUserID: "user-1"}); err == nil {
+		t.Error("expected error for duplicate external user id")
+	}
+
+	f, err := os.Open("../testdata/selfie.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	doc, err := client.AddDocument(a.ID, sumsub.DocumentMetaData{IDDocType: sumsub.DocSetType_SELFIE, Country: "USA"}, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.ImageID == "" || len(srv.Documents(a.ID)) != 1 {
+		t.Error("document is not stored", doc.ImageID)
+	}
+
+	if err := client.SimulateApproval(a.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := client.GetApplicantStatus(a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, pass := status.IsPass(); !status.IsCompleted() || !pass {
+		t.Error("applicant is not approved", status.ReviewStatus, status.ReviewResult.ReviewAnswer)
+	}
+
+	select {
+	case w := <-reviewed:
+		if w.ApplicantID != a.ID || w.ReviewResult.ReviewAnswer != sumsub.ReviewResultGREEN {
+			t.Error("wrong webhook", w.ApplicantID, w.ReviewResult.ReviewAnswer)
+		}
+	default:
+		t.Error("applicantReviewed webhook is not received")
+	}
+
+	if _, err := client.GetApplicantStatus("unknown"); err == nil {
+		t.Error("expected error for unknown applicant")
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	srv := NewServer("user", "pass")
+	defer srv.Close()
+
+	if _, err := sumsub.NewClient(srv.URL, "user", "wrong"); err == nil {
+		t.Error("expected error for invalid credentials")
+	}
+}
@@ -0,0 +1,82 @@
+package sumsub
+
+import (
+	"github.com/imroc/req"
+)
+
+// GetApplicantTags returns tags of the applicant, they are requested from the
+// api even if client is created with WithCache option
+func (s *SumSub) GetApplicantTags(id string) ([]string, error) {
+	a, err := s.fetchApplicant(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Tags, nil
+}
+
+// SetApplicantTags replaces all tags of the applicant
+// POST /resources/applicants/{applicantId}/tags
+func (s *SumSub) SetApplicantTags(id string, tags []string) error {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	resp, err := s.do("POST", "resources/applicants/"+id+"/tags", req.BodyJSON(tags))
+	return handleResponse(resp, err)
+}
+
+// AddApplicantTags appends tags to the existing applicant tags. The api has no
+// endpoint to add single tag, so current tags are requested and the whole list
+// is sent with SetApplicantTags. It is not atomic: concurrent changes of the
+// applicant tags made between these requests, by this or another client, are
+// overwritten. Callers which change tags of the same applicant concurrently
+// should serialize the calls
+func (s *SumSub) AddApplicantTags(id string, tags ...string) error {
+	current, err := s.GetApplicantTags(id)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if !containsString(current, tag) {
+			current = append(current, tag)
+		}
+	}
+
+	return s.SetApplicantTags(id, current)
+}
+
+// RemoveApplicantTags removes tags from the applicant. Like AddApplicantTags
+// it reads current tags and sends the rest with SetApplicantTags, concurrent
+// changes of the applicant tags made in between are lost
+func (s *SumSub) RemoveApplicantTags(id string, tags ...string) error {
+	current, err := s.GetApplicantTags(id)
+	if err != nil {
+		return err
+	}
+
+	var rest []string
+	for _, tag := range current {
+		if !containsString(tags, tag) {
+			rest = append(rest, tag)
+		}
+	}
+
+	return s.SetApplicantTags(id, rest)
+}
+
+// HasTag returns true if applicant is labeled with the tag
+func (a Applicant) HasTag(tag string) bool {
+	return containsString(a.Tags, tag)
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
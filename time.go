@@ -0,0 +1,101 @@
+package sumsub
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// TimeLayout is main format of sumsub timestamps
+const TimeLayout = "2006-01-02 15:04:05"
+
+// DateLayout is format of dates, e.g. date of birth or document issue date
+const DateLayout = "2006-01-02"
+
+// timeLayouts are formats of timestamps returned by different endpoints
+var timeLayouts = []string{
+	TimeLayout,
+	"2006-01-02 15:04:05-0700",
+	"2006-01-02 15:04:05.000",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000-0700",
+	DateLayout,
+}
+
+// Time is sumsub timestamp decoded into time.Time, timestamps are in UTC.
+// If value can't be parsed, Time is zero and original value is kept in Raw.
+type Time struct {
+	time.Time
+	Raw string
+}
+
+// ParseTime parses timestamp in any of the known sumsub formats
+func ParseTime(s string) (Time, error) {
+	var err error
+	for _, layout := range timeLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return Time{Time: t}, nil
+		}
+	}
+
+	return Time{Raw: s}, err
+}
+
+func (t Time) String() string {
+	if t.Time.IsZero() {
+		return t.Raw
+	}
+
+	return t.Time.UTC().Format(TimeLayout)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.Time.IsZero() && t.Raw == "" {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.String())
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+
+	*t, _ = ParseTime(s)
+	return nil
+}
+
+// Date is calendar date written as yyyy-mm-dd, e.g. date of birth. It is kept
+// as string so that empty dates are omitted from requests.
+type Date string
+
+// NewDate formats t as yyyy-mm-dd
+func NewDate(t time.Time) Date {
+	return Date(t.Format(DateLayout))
+}
+
+// Time parses date, zero time is returned for empty date
+func (d Date) Time() (time.Time, error) {
+	if d == "" {
+		return time.Time{}, nil
+	}
+
+	return time.ParseInLocation(DateLayout, string(d), time.UTC)
+}
+
+func (d Date) String() string {
+	return string(d)
+}
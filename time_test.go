@@ -0,0 +1,84 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	var v struct {
+		CreatedAt  Time `json:"createdAt"`
+		ReviewDate Time `json:"reviewDate"`
+		Broken     Time `json:"broken"`
+		Empty      Time `json:"empty"`
+	}
+
+	data := []byte(`{"createdAt": "2020-06-24 05:05:14", "reviewDate": "2020-06-24T05:05:14.123+03:00", "broken": "yesterday", "empty": null}`)
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if !v.CreatedAt.Equal(time.Date(2020, 6, 24, 5, 5, 14, 0, time.UTC)) {
+		t.Error("wrong createdAt", v.CreatedAt)
+	}
+	if !v.ReviewDate.Equal(time.Date(2020, 6, 24, 2, 5, 14, 123e6, time.UTC)) {
+		t.Error("wrong reviewDate", v.ReviewDate)
+	}
+	if !v.Broken.IsZero() || v.Broken.Raw != "yesterday" {
+		t.Error("raw value is not preserved", v.Broken)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"createdAt":"2020-06-24 05:05:14","reviewDate":"2020-06-24 02:05:14","broken":"yesterday","empty":null}` {
+		t.Error("wrong encoding", string(out))
+	}
+}
+
+func TestDate(t *testing.T) {
+	d := NewDate(time.Date(1990, 2, 3, 0, 0, 0, 0, time.UTC))
+	if d != "1990-02-03" {
+		t.Error("wrong date", d)
+	}
+
+	tm, err := d.Time()
+	if err != nil || tm.Year() != 1990 || tm.Month() != 2 || tm.Day() != 3 {
+		t.Error("wrong time", tm, err)
+	}
+}
+
+func TestZeroTimeOmitted(t *testing.T) {
+	bodies := map[string]interface{}{
+		"applicant":   Applicant{ExternalUserID: "user-1"},
+		"note":        Note{Note: "note"},
+		"agreement":   Agreement{Text: "terms"},
+		"transaction": Transaction{TxnID: "txn-1"},
+	}
+	for name, body := range bodies {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		for _, field := range []string{"createdAt", "review", "acceptedAt", "txnDate"} {
+			if strings.Contains(string(data), `"`+field+`"`) {
+				t.Errorf("%s: empty %s is not omitted %s", name, field, data)
+			}
+		}
+	}
+
+	created, _ := ParseTime("2020-06-24 05:05:14")
+	a := Applicant{ID: "id", CreatedAt: created, Review: ApplicantReview{ReviewStatus: ReviewStatusPending}}
+	data, _ := json.Marshal(a)
+
+	var decoded Applicant
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.CreatedAt.Equal(created.Time) || decoded.Review.ReviewStatus != ReviewStatusPending {
+		t.Error("applicant fields are lost", string(data))
+	}
+}
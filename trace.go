@@ -0,0 +1,90 @@
+package sumsub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// Tracer starts spans of the api requests, it is used to add requests to
+// distributed traces, e.g. by sumsubotel package
+type Tracer interface {
+	// StartSpan is called before api request with context passed to Do or
+	// WaitForReview, background context is used by other methods. Returned
+	// header is added to the request to propagate trace, end is called when
+	// response is received
+	StartSpan(ctx context.Context, span Span) (header http.Header, end func(SpanResult))
+}
+
+// Span describes api request
+type Span struct {
+	Method string
+
+	// Endpoint is url path with ids replaced by {id}
+	Endpoint string
+
+	// ApplicantHash is hashed id of the applicant the request is made for,
+	// it is empty if url path does not contain applicant id
+	ApplicantHash string
+}
+
+// SpanResult is outcome of the api request, status is 0 if response is not
+// received
+type SpanResult struct {
+	StatusCode    int
+	CorrelationID string
+	Err           error
+}
+
+// WithTracer option sets tracer of the api requests
+func WithTracer(t Tracer) Option {
+	return func(s *SumSub) {
+		s.tracer = t
+	}
+}
+
+// startSpan of the request if tracer is set, context is taken from request
+// options v, returned options contain propagation header
+func (s *SumSub) startSpan(method, urlpath string, v []interface{}) ([]interface{}, func(SpanResult)) {
+	if s.tracer == nil {
+		return v, func(SpanResult) {}
+	}
+
+	header, end := s.tracer.StartSpan(requestContext(v), Span{
+		Method:        method,
+		Endpoint:      endpoint(urlpath),
+		ApplicantHash: applicantHash(urlpath),
+	})
+	if len(header) > 0 {
+		v = append(v, header)
+	}
+
+	return v, end
+}
+
+// applicantHash returns hash of the applicant id from the url path, raw id is
+// not exposed to traces
+func applicantHash(urlpath string) string {
+	id := pathApplicantID(urlpath)
+	if id == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:8])
+}
+
+// pathApplicantID returns applicant id from the url path, e.g.
+// resources/applicants/{applicantId}/status, or empty string
+func pathApplicantID(urlpath string) string {
+	segments := strings.Split(strings.Trim(urlpath, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == "applicants" && segments[i+1] != "-" && !isWord(segments[i+1]) {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
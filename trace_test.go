@@ -0,0 +1,68 @@
+package sumsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type ctxKey struct{}
+
+type testTracer struct {
+	spans   []Span
+	results []SpanResult
+	ctx     context.Context
+}
+
+func (t *testTracer) StartSpan(ctx context.Context, span Span) (http.Header, func(SpanResult)) {
+	t.ctx = ctx
+	t.spans = append(t.spans, span)
+
+	return http.Header{"Traceparent": {"trace"}}, func(result SpanResult) {
+		t.results = append(t.results, result)
+	}
+}
+
+func TestApplicantHash(t *testing.T) {
+	hash := applicantHash("resources/applicants/5cb56e8e0a975a35f333cb83/status")
+	if len(hash) != 16 || hash == "5cb56e8e0a975a35" {
+		t.Error("wrong hash", hash)
+	}
+
+	if hash := applicantHash("resources/applicants/-/levels"); hash != "" {
+		t.Error("unexpected hash", hash)
+	}
+}
+
+func TestTracer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Traceparent") != "trace" {
+			t.Error("trace header is not propagated")
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"description": "not found", "code": 404, "correlationId": "req-1"}`)
+	}))
+	defer srv.Close()
+
+	tracer := &testTracer{}
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour), tracer: tracer}
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "parent")
+	s.Do(ctx, "GET", "resources/applicants/5cb56e8e0a975a35f333cb83/one", nil, nil, nil)
+
+	if tracer.ctx == nil || tracer.ctx.Value(ctxKey{}) != "parent" {
+		t.Error("caller context is not propagated")
+	}
+	if len(tracer.spans) != 1 || tracer.spans[0].Endpoint != "/resources/applicants/{id}/one" || tracer.spans[0].ApplicantHash == "" {
+		t.Errorf("unexpected spans %+v", tracer.spans)
+	}
+	if len(tracer.results) != 1 || tracer.results[0].StatusCode != 404 || tracer.results[0].CorrelationID != "req-1" {
+		t.Errorf("unexpected results %+v", tracer.results)
+	}
+}
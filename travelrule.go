@@ -0,0 +1,115 @@
+package sumsub
+
+// travel rule transfer statuses
+const (
+	TravelRuleAwaiting  = "awaiting"
+	TravelRuleConfirmed = "confirmed"
+	TravelRuleRejected  = "rejected"
+)
+
+// TravelRuleTransfer is crypto transfer with originator and beneficiary data
+// exchanged between VASPs
+type TravelRuleTransfer struct {
+	TxnID   string
+	TxnDate Time
+
+	// Direction is TxnDirectionOut for withdrawals, applicant is originator,
+	// and TxnDirectionIn for deposits, applicant is beneficiary
+	Direction   string
+	Amount      Decimal
+	Currency    string
+	CryptoChain string
+	TxnHash     string
+
+	Originator  TravelRuleParty
+	Beneficiary TravelRuleParty
+}
+
+// TravelRuleParty is originator or beneficiary of the transfer
+type TravelRuleParty struct {
+	ExternalUserID string
+	FullName       string
+	DateOfBirth    Date
+	Address        *Address
+
+	// WalletAddress is blockchain address of the party
+	WalletAddress string
+
+	// VASP is provider servicing the party wallet
+	VASP TxnInstitutionInfo
+}
+
+func (p TravelRuleParty) participant() TxnParticipant {
+	vasp := p.VASP
+	return TxnParticipant{
+		ExternalUserID:  p.ExternalUserID,
+		Type:            ApplicantTypeIndividual,
+		FullName:        p.FullName,
+		DateOfBirth:     p.DateOfBirth,
+		Address:         p.Address,
+		PaymentMethod:   &TxnPaymentMethod{Type: CurrencyTypeCrypto, AccountID: p.WalletAddress},
+		InstitutionInfo: &vasp,
+	}
+}
+
+// Transaction converts transfer to KYT transaction of travelRule type
+func (t TravelRuleTransfer) Transaction() Transaction {
+	applicant, counterparty := t.Originator, t.Beneficiary
+	if t.Direction == TxnDirectionIn {
+		applicant, counterparty = t.Beneficiary, t.Originator
+	}
+
+	c := counterparty.participant()
+	txn := Transaction{
+		TxnID: t.TxnID,
+		Type:  TxnTypeTravelRule,
+		Info: TxnInfo{
+			Direction:    t.Direction,
+			Amount:       t.Amount,
+			CurrencyCode: t.Currency,
+			CurrencyType: CurrencyTypeCrypto,
+			CryptoParams: &TxnCryptoParams{CryptoChain: t.CryptoChain, TxnHash: t.TxnHash},
+		},
+		Applicant:    applicant.participant(),
+		Counterparty: &c,
+	}
+	if !t.TxnDate.IsZero() {
+		date := t.TxnDate
+		txn.TxnDate = &date
+	}
+
+	return txn
+}
+
+// SubmitTravelRuleTransfer sends originator and beneficiary data of the
+// applicant transfer to the counterparty VASP
+// POST /resources/applicants/{applicantId}/kyt/txns/-/data
+func (s *SumSub) SubmitTravelRuleTransfer(id string, t TravelRuleTransfer) (TransactionResult, error) {
+	return s.SubmitTransaction(id, t.Transaction())
+}
+
+// TravelRuleStatus returns confirmation status of the transfer by the
+// counterparty VASP
+func (r TransactionResult) TravelRuleStatus() string {
+	status := ApplicantStatus{ReviewStatus: r.Review.ReviewStatus, ReviewResult: r.Review.ReviewResult}
+	if !status.IsCompleted() {
+		return TravelRuleAwaiting
+	}
+
+	if _, pass := status.IsPass(); pass {
+		return TravelRuleConfirmed
+	}
+
+	return TravelRuleRejected
+}
+
+// GetTravelRuleStatus returns confirmation status of the transfer
+// GET /resources/kyt/txns/{txnId}/one
+func (s *SumSub) GetTravelRuleStatus(id string) (string, error) {
+	result, err := s.GetTransaction(id)
+	if err != nil {
+		return "", err
+	}
+
+	return result.TravelRuleStatus(), nil
+}
@@ -0,0 +1,506 @@
+package sumsub
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/imroc/req"
+)
+
+// names of the document upload multipart parts
+const (
+	PartMetadata = "metadata"
+	PartContent  = "content"
+)
+
+// MultipartOptions control encoding of the document upload body, it helps to
+// pass strict gateways that reject unusual multipart bodies
+type MultipartOptions struct {
+	// PartOrder is order of the parts, metadata goes first by default
+	PartOrder []string
+
+	// Boundary of the multipart body, random boundary is generated if empty
+	Boundary string
+
+	// FileName returns filename of the content part
+	FileName func(metadata DocumentMetaData) string
+}
+
+// WithMultipart configures encoding of document uploads
+func WithMultipart(opts MultipartOptions) Option {
+	return func(s *SumSub) {
+		s.multipart = opts
+	}
+}
+
+// WithDocumentWarnings requests structured warnings about uploaded documents,
+// e.g. blurry image or screenshot, they are returned in DocumentResult
+func WithDocumentWarnings() Option {
+	return func(s *SumSub) {
+		s.docWarnings = true
+	}
+}
+
+// codes of the document upload warnings
+const (
+	DocWarningBlurry      = "blurry"
+	DocWarningGlare       = "glare"
+	DocWarningScreenshot  = "screenshot"
+	DocWarningWrongSide   = "wrongSide"
+	DocWarningWrongType   = "wrongDocType"
+	DocWarningCropped     = "cropped"
+	DocWarningBlackWhite  = "blackAndWhite"
+	DocWarningLowQuality  = "lowQuality"
+	DocWarningFaceMissing = "noFace"
+)
+
+// DocumentWarning is issue of the uploaded document found by sumsub
+type DocumentWarning struct {
+	Code        string `json:"code"`
+	Description string `json:"description,omitempty"`
+}
+
+// HasWarning returns true if uploaded document has warning with the code
+func (result DocumentResult) HasWarning(code string) bool {
+	for _, w := range result.Warnings {
+		if w.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// Document is file uploaded to the applicant
+type Document struct {
+	Metadata DocumentMetaData
+	Content  io.Reader
+
+	// FileName of the content, it is generated from the document type if empty
+	FileName string
+
+	// ContentType of the content, it is detected from the content if empty
+	ContentType string
+
+	// MaxSize of the content in bytes, size is not limited if zero
+	MaxSize int64
+}
+
+// supported content types of the documents and their file extensions
+var contentTypeExt = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"application/pdf": ".pdf",
+}
+
+// supported content types of the video documents
+var videoContentTypeExt = map[string]string{
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+	"video/quicktime": ".mov",
+}
+
+// UploadDocument to the applicant, content type is detected if not specified
+// before sending, metadata is validated if client is created with
+// WithValidation option
+// POST /resources/applicants/{applicantId}/info/idDoc
+func (s *SumSub) UploadDocument(id string, doc Document) (result DocumentResult, err error) {
+	return s.uploadDocument(id, doc)
+}
+
+// uploadDocument sends document, v are additional request options, e.g.
+// http client with longer timeout
+func (s *SumSub) uploadDocument(id string, doc Document, v ...interface{}) (result DocumentResult, err error) {
+	return s.upload("resources/applicants/"+id+"/info/idDoc", id, doc, v...)
+}
+
+// upload sends document to urlpath, id is applicant or action id the
+// document is uploaded to
+func (s *SumSub) upload(urlpath, id string, doc Document, v ...interface{}) (result DocumentResult, err error) {
+	if s.validate {
+		if err := doc.Metadata.Validate(); err != nil {
+			return result, err
+		}
+	}
+
+	if err := doc.limitSize(); err != nil {
+		return result, err
+	}
+
+	// streamed is hash of the content which is not seekable, it is computed
+	// while content is uploaded
+	var streamed hash.Hash
+
+	if s.duplicates != DuplicatesAllow {
+		checksum, ok, err := doc.checksum()
+		if err != nil {
+			return result, err
+		}
+
+		if !ok {
+			streamed = sha256.New()
+			doc.Content = io.TeeReader(doc.Content, streamed)
+		} else if prev, ok := s.uploads.get(id, checksum); ok {
+			prev.Duplicate = true
+			if s.duplicates == DuplicatesSkip {
+				return prev, nil
+			}
+
+			log.Warningf("document %s is already uploaded to %s, image %s", checksum, id, prev.ImageID)
+			result.Duplicate = true
+		}
+
+		result.Checksum = checksum
+	}
+
+	if err := doc.detectContentType(); err != nil {
+		return result, err
+	}
+
+	content := &countingReader{r: doc.Content}
+	doc.Content = content
+
+	body, contentType, err := s.multipart.stream(doc)
+	if err != nil {
+		return result, err
+	}
+	defer body.Close()
+
+	header := req.Header{"Content-Type": contentType}
+	if s.docWarnings {
+		header["X-Return-Doc-Warnings"] = "true"
+	}
+
+	resp, err := s.do("POST", urlpath, append(v, header, body)...)
+	if err := handleResponse(resp, err); err != nil {
+		return result, err
+	}
+
+	if err := s.decode(resp, &result); err != nil {
+		return result, err
+	}
+
+	result.ImageID = resp.Response().Header.Get("X-Image-Id")
+	if streamed != nil {
+		result.Checksum = hex.EncodeToString(streamed.Sum(nil))
+		if prev, ok := s.uploads.get(id, result.Checksum); ok {
+			log.Warningf("document %s is already uploaded to %s, image %s", result.Checksum, id, prev.ImageID)
+			result.Duplicate = true
+		}
+	}
+	if result.Checksum != "" {
+		s.uploads.add(id, result)
+	}
+
+	if s.observer != nil {
+		s.observer.ObserveUpload(content.n)
+	}
+
+	return result, nil
+}
+
+// detectContentType sniffs content type from the first bytes of the content
+// if it is not specified and checks that it is supported
+func (doc *Document) detectContentType() error {
+	if doc.Content == nil {
+		return errors.New("document content is empty")
+	}
+
+	if doc.ContentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(doc.Content, head)
+		if err == io.EOF {
+			return errors.New("document content is empty")
+		} else if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+
+		doc.ContentType = http.DetectContentType(head[:n])
+		doc.Content = io.MultiReader(bytes.NewReader(head[:n]), doc.Content)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(doc.ContentType)
+	if err != nil {
+		return err
+	}
+
+	if doc.fileExt(mediaType) == "" {
+		return fmt.Errorf("unsupported %s document content type %s", doc.Metadata.IDDocType, mediaType)
+	}
+	doc.ContentType = mediaType
+
+	return nil
+}
+
+// fileExt returns extension of the file for supported content type
+func (doc *Document) fileExt(contentType string) string {
+	if doc.Metadata.IDDocType == DocSetType_VIDEO_SELFIE {
+		return videoContentTypeExt[contentType]
+	}
+
+	return contentTypeExt[contentType]
+}
+
+// limitSize checks size of the content if it is known, otherwise content is
+// read with limit
+func (doc *Document) limitSize() error {
+	if doc.MaxSize <= 0 || doc.Content == nil {
+		return nil
+	}
+
+	size := int64(-1)
+	switch r := doc.Content.(type) {
+	case interface{ Len() int }:
+		size = int64(r.Len())
+	case interface{ Stat() (os.FileInfo, error) }:
+		if stat, err := r.Stat(); err == nil && stat.Mode().IsRegular() {
+			size = stat.Size()
+		}
+	}
+
+	if size > doc.MaxSize {
+		return ErrDocumentTooLarge
+	}
+	if size < 0 {
+		doc.Content = &sizeLimitReader{r: doc.Content, limit: doc.MaxSize}
+	}
+
+	return nil
+}
+
+// stream returns reader of the multipart document upload body and its content
+// type, body is encoded on the fly while it is read, so file is never
+// buffered in memory
+func (opts MultipartOptions) stream(doc Document) (io.ReadCloser, string, error) {
+	order, err := opts.partOrder()
+	if err != nil {
+		return nil, "", err
+	}
+
+	pr, pw := io.Pipe()
+
+	mw, err := opts.writer(pw)
+	if err != nil {
+		return nil, "", err
+	}
+
+	go func() {
+		pw.CloseWithError(opts.writeParts(mw, order, doc))
+	}()
+
+	return pr, mw.FormDataContentType(), nil
+}
+
+func (opts MultipartOptions) partOrder() ([]string, error) {
+	order := opts.PartOrder
+	if len(order) == 0 {
+		order = []string{PartMetadata, PartContent}
+	}
+
+	if len(order) != 2 || order[0] == order[1] {
+		return nil, errors.New("part order should contain metadata and content parts")
+	}
+
+	for _, part := range order {
+		if part != PartMetadata && part != PartContent {
+			return nil, errors.New("unknown multipart part " + part)
+		}
+	}
+
+	return order, nil
+}
+
+func (opts MultipartOptions) writer(w io.Writer) (*multipart.Writer, error) {
+	mw := multipart.NewWriter(w)
+	if opts.Boundary != "" {
+		if err := mw.SetBoundary(opts.Boundary); err != nil {
+			return nil, err
+		}
+	}
+
+	return mw, nil
+}
+
+func (opts MultipartOptions) writeParts(mw *multipart.Writer, order []string, doc Document) error {
+	for _, part := range order {
+		var err error
+		if part == PartMetadata {
+			err = writeMetadataPart(mw, doc.Metadata)
+		} else {
+			err = writeContentPart(mw, opts.fileName(doc), doc.ContentType, doc.Content)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return mw.Close()
+}
+
+// fileName of the content part, explicit document filename is used first,
+// then filename from options and then it is generated from the document type
+func (opts MultipartOptions) fileName(doc Document) string {
+	if doc.FileName != "" {
+		return doc.FileName
+	}
+
+	if opts.FileName != nil {
+		return opts.FileName(doc.Metadata)
+	}
+
+	name := strings.ToLower(doc.Metadata.IDDocType)
+	if name == "" {
+		name = "document"
+	}
+
+	return name + doc.fileExt(doc.ContentType)
+}
+
+func writeMetadataPart(mw *multipart.Writer, metadata DocumentMetaData) error {
+	w, err := mw.CreateFormField(PartMetadata)
+	if err != nil {
+		return err
+	}
+
+	return json.NewEncoder(w).Encode(metadata)
+}
+
+func writeContentPart(mw *multipart.Writer, filename, contentType string, file io.Reader) error {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, PartContent, quoteEscaper.Replace(filename)))
+	h.Set("Content-Type", contentType)
+
+	w, err := mw.CreatePart(h)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// DefaultMaxDownloadSize is limit of the document downloaded by
+// AddDocumentFromURL if limit is not specified
+const DefaultMaxDownloadSize = 50 << 20
+
+// ErrDocumentTooLarge is returned when document exceeds size limit
+var ErrDocumentTooLarge = errors.New("document is too large")
+
+// AddDocumentBytes uploads document from memory
+func (s *SumSub) AddDocumentBytes(id string, metadata DocumentMetaData, data []byte) (DocumentResult, error) {
+	return s.UploadDocument(id, Document{Metadata: metadata, Content: bytes.NewReader(data)})
+}
+
+// AddDocumentFromURL downloads document, e.g. from object storage, and
+// uploads it to the applicant without buffering. Download is aborted if
+// document is larger than maxSize bytes, DefaultMaxDownloadSize is used if
+// maxSize is zero.
+func (s *SumSub) AddDocumentFromURL(id string, metadata DocumentMetaData, fileURL string, maxSize int64) (result DocumentResult, err error) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxDownloadSize
+	}
+
+	resp, err := s.httpClient().Get(fileURL)
+	if err != nil {
+		return result, err
+	}
+
+	r := resp.Response()
+	defer r.Body.Close()
+
+	if r.StatusCode != 200 {
+		return result, fmt.Errorf("failed to download document: %s", r.Status)
+	}
+	if r.ContentLength > maxSize {
+		return result, ErrDocumentTooLarge
+	}
+
+	doc := Document{
+		Metadata: metadata,
+		Content:  &sizeLimitReader{r: r.Body, limit: maxSize},
+		FileName: path.Base(r.Request.URL.Path),
+	}
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && contentTypeExt[mediaType] != "" {
+		doc.ContentType = mediaType
+	}
+	if path.Ext(doc.FileName) == "" {
+		doc.FileName = ""
+	}
+
+	return s.UploadDocument(id, doc)
+}
+
+// sizeLimitReader fails with ErrDocumentTooLarge when more than limit bytes
+// are read
+type sizeLimitReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		return n, ErrDocumentTooLarge
+	}
+
+	return n, err
+}
+
+// AddDocumentSides uploads front and back sides of the document with shared
+// metadata as one operation, front side image is deactivated if upload of the
+// back side fails
+func (s *SumSub) AddDocumentSides(id string, metadata DocumentMetaData, front, back io.Reader) (frontResult, backResult DocumentResult, err error) {
+	metadata.IDDocSubType = DocSetSubTypeFront
+	frontResult, err = s.AddDocument(id, metadata, front)
+	if err != nil {
+		return frontResult, backResult, fmt.Errorf("front side: %v", err)
+	}
+
+	metadata.IDDocSubType = DocSetSubTypeBack
+	backResult, err = s.AddDocument(id, metadata, back)
+	if err == nil {
+		return frontResult, backResult, nil
+	}
+
+	err = fmt.Errorf("back side: %v", err)
+	if rollbackErr := s.deactivateUploadedImage(id, frontResult.ImageID); rollbackErr != nil {
+		err = fmt.Errorf("%v, front side is not deactivated: %v", err, rollbackErr)
+	}
+
+	return frontResult, backResult, err
+}
+
+// deactivateUploadedImage deactivates image of the applicant by image id
+func (s *SumSub) deactivateUploadedImage(id, imageID string) error {
+	if imageID == "" {
+		return errors.New("image id is unknown")
+	}
+
+	a, err := s.GetApplicant(id)
+	if err != nil {
+		return err
+	}
+
+	return s.DeactivateDocumentImage(a.InspectionID, imageID)
+}
@@ -0,0 +1,165 @@
+package sumsub
+
+import (
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMultipartStream(t *testing.T) {
+	opts := MultipartOptions{
+		PartOrder: []string{PartContent, PartMetadata},
+		Boundary:  "sumsub-boundary",
+		FileName:  func(DocumentMetaData) string { return "selfie.jpg" },
+	}
+
+	doc := Document{
+		Metadata:    DocumentMetaData{IDDocType: DocSetType_SELFIE, Country: "USA"},
+		Content:     strings.NewReader("content"),
+		ContentType: "image/jpeg",
+	}
+
+	body, contentType, err := opts.stream(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params["boundary"] != opts.Boundary {
+		t.Error("wrong boundary", params["boundary"])
+	}
+
+	mr := multipart.NewReader(body, params["boundary"])
+
+	part, err := mr.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if part.FormName() != PartContent || part.FileName() != "selfie.jpg" {
+		t.Error("wrong first part", part.FormName(), part.FileName())
+	}
+
+	part, err = mr.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadAll(part)
+	if part.FormName() != PartMetadata || !strings.Contains(string(data), `"idDocType":"SELFIE"`) {
+		t.Error("wrong second part", part.FormName(), string(data))
+	}
+
+	if _, _, err := (MultipartOptions{PartOrder: []string{PartContent}}).stream(Document{}); err == nil {
+		t.Error("expected error for incomplete part order")
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	f, err := os.Open("testdata/selfie.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	doc := Document{
+		Metadata: DocumentMetaData{IDDocType: DocSetType_SELFIE},
+		Content:  f,
+	}
+	if err := doc.detectContentType(); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.ContentType != "image/jpeg" {
+		t.Error("wrong content type", doc.ContentType)
+	}
+
+	if name := (MultipartOptions{}).fileName(doc); name != "selfie.jpg" {
+		t.Error("wrong filename", name)
+	}
+
+	data, _ := ioutil.ReadAll(doc.Content)
+	stat, _ := f.Stat()
+	if int64(len(data)) != stat.Size() {
+		t.Error("content is truncated after detection")
+	}
+
+	doc = Document{Content: strings.NewReader("plain text")}
+	if err := doc.detectContentType(); err == nil {
+		t.Error("expected error for unsupported content type")
+	}
+}
+
+func TestSizeLimitReader(t *testing.T) {
+	r := &sizeLimitReader{r: strings.NewReader("0123456789"), limit: 5}
+	if _, err := ioutil.ReadAll(r); err != ErrDocumentTooLarge {
+		t.Error("expected ErrDocumentTooLarge, got", err)
+	}
+
+	r = &sizeLimitReader{r: strings.NewReader("0123456789"), limit: 10}
+	if data, err := ioutil.ReadAll(r); err != nil || len(data) != 10 {
+		t.Error("unexpected result", len(data), err)
+	}
+}
+
+func TestDocumentLimitSize(t *testing.T) {
+	doc := Document{Content: strings.NewReader("0123456789"), MaxSize: 5}
+	if err := doc.limitSize(); err != ErrDocumentTooLarge {
+		t.Error("expected ErrDocumentTooLarge, got", err)
+	}
+
+	doc = Document{Content: ioutil.NopCloser(strings.NewReader("0123456789")), MaxSize: 5}
+	if err := doc.limitSize(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(doc.Content); err != ErrDocumentTooLarge {
+		t.Error("expected ErrDocumentTooLarge on read, got", err)
+	}
+}
+
+func TestDocumentChecksum(t *testing.T) {
+	const sha = "84d89877f0d4041efb6bf91a16f0248f2fd573e6af05c19f96bedb9f882f7882"
+
+	doc := Document{Content: strings.NewReader("0123456789")}
+	checksum, ok, err := doc.checksum()
+	if err != nil || !ok || checksum != sha {
+		t.Fatal("wrong checksum", checksum, err)
+	}
+	if data, _ := ioutil.ReadAll(doc.Content); string(data) != "0123456789" {
+		t.Error("content is not rewound", string(data))
+	}
+
+	doc = Document{Content: ioutil.NopCloser(strings.NewReader("0123456789"))}
+	if _, ok, err := doc.checksum(); err != nil || ok {
+		t.Fatal("content which is not seekable should not be read", err)
+	}
+	if data, _ := ioutil.ReadAll(doc.Content); string(data) != "0123456789" {
+		t.Error("content is read", string(data))
+	}
+}
+
+func TestUploadsRegistry(t *testing.T) {
+	s := &SumSub{}
+	WithDuplicateCheck(DuplicatesSkip, time.Millisecond)(s)
+
+	s.uploads.add("id", DocumentResult{Checksum: "sum1", ImageID: "image1"})
+	if prev, ok := s.uploads.get("id", "sum1"); !ok || prev.ImageID != "image1" {
+		t.Fatal("uploaded document is not found")
+	}
+
+	time.Sleep(2 * time.Millisecond)
+	if _, ok := s.uploads.get("id", "sum1"); ok {
+		t.Error("expired document is found")
+	}
+
+	s.uploads.add("id", DocumentResult{Checksum: "sum2"})
+	if len(s.uploads.results) != 1 {
+		t.Error("expired documents are not removed", len(s.uploads.results))
+	}
+}
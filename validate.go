@@ -0,0 +1,333 @@
+package sumsub
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// countries is set of ISO 3166-1 alpha-3 country codes, XKX is used by sumsub
+// for Kosovo
+var countries = map[string]bool{
+	"ABW": true, "AFG": true, "AGO": true, "AIA": true, "ALA": true, "ALB": true, "AND": true, "ARE": true,
+	"ARG": true, "ARM": true, "ASM": true, "ATA": true, "ATF": true, "ATG": true, "AUS": true, "AUT": true,
+	"AZE": true, "BDI": true, "BEL": true, "BEN": true, "BES": true, "BFA": true, "BGD": true, "BGR": true,
+	"BHR": true, "BHS": true, "BIH": true, "BLM": true, "BLR": true, "BLZ": true, "BMU": true, "BOL": true,
+	"BRA": true, "BRB": true, "BRN": true, "BTN": true, "BVT": true, "BWA": true, "CAF": true, "CAN": true,
+	"CCK": true, "CHE": true, "CHL": true, "CHN": true, "CIV": true, "CMR": true, "COD": true, "COG": true,
+	"COK": true, "COL": true, "COM": true, "CPV": true, "CRI": true, "CUB": true, "CUW": true, "CXR": true,
+	"CYM": true, "CYP": true, "CZE": true, "DEU": true, "DJI": true, "DMA": true, "DNK": true, "DOM": true,
+	"DZA": true, "ECU": true, "EGY": true, "ERI": true, "ESH": true, "ESP": true, "EST": true, "ETH": true,
+	"FIN": true, "FJI": true, "FLK": true, "FRA": true, "FRO": true, "FSM": true, "GAB": true, "GBR": true,
+	"GEO": true, "GGY": true, "GHA": true, "GIB": true, "GIN": true, "GLP": true, "GMB": true, "GNB": true,
+	"GNQ": true, "GRC": true, "GRD": true, "GRL": true, "GTM": true, "GUF": true, "GUM": true, "GUY": true,
+	"HKG": true, "HMD": true, "HND": true, "HRV": true, "HTI": true, "HUN": true, "IDN": true, "IMN": true,
+	"IND": true, "IOT": true, "IRL": true, "IRN": true, "IRQ": true, "ISL": true, "ISR": true, "ITA": true,
+	"JAM": true, "JEY": true, "JOR": true, "JPN": true, "KAZ": true, "KEN": true, "KGZ": true, "KHM": true,
+	"KIR": true, "KNA": true, "KOR": true, "KWT": true, "LAO": true, "LBN": true, "LBR": true, "LBY": true,
+	"LCA": true, "LIE": true, "LKA": true, "LSO": true, "LTU": true, "LUX": true, "LVA": true, "MAC": true,
+	"MAF": true, "MAR": true, "MCO": true, "MDA": true, "MDG": true, "MDV": true, "MEX": true, "MHL": true,
+	"MKD": true, "MLI": true, "MLT": true, "MMR": true, "MNE": true, "MNG": true, "MNP": true, "MOZ": true,
+	"MRT": true, "MSR": true, "MTQ": true, "MUS": true, "MWI": true, "MYS": true, "MYT": true, "NAM": true,
+	"NCL": true, "NER": true, "NFK": true, "NGA": true, "NIC": true, "NIU": true, "NLD": true, "NOR": true,
+	"NPL": true, "NRU": true, "NZL": true, "OMN": true, "PAK": true, "PAN": true, "PCN": true, "PER": true,
+	"PHL": true, "PLW": true, "PNG": true, "POL": true, "PRI": true, "PRK": true, "PRT": true, "PRY": true,
+	"PSE": true, "PYF": true, "QAT": true, "REU": true, "ROU": true, "RUS": true, "RWA": true, "SAU": true,
+	"SDN": true, "SEN": true, "SGP": true, "SGS": true, "SHN": true, "SJM": true, "SLB": true, "SLE": true,
+	"SLV": true, "SMR": true, "SOM": true, "SPM": true, "SRB": true, "SSD": true, "STP": true, "SUR": true,
+	"SVK": true, "SVN": true, "SWE": true, "SWZ": true, "SXM": true, "SYC": true, "SYR": true, "TCA": true,
+	"TCD": true, "TGO": true, "THA": true, "TJK": true, "TKL": true, "TKM": true, "TLS": true, "TON": true,
+	"TTO": true, "TUN": true, "TUR": true, "TUV": true, "TWN": true, "TZA": true, "UGA": true, "UKR": true,
+	"UMI": true, "URY": true, "USA": true, "UZB": true, "VAT": true, "VCT": true, "VEN": true, "VGB": true,
+	"VIR": true, "VNM": true, "VUT": true, "WLF": true, "WSM": true, "XKX": true, "YEM": true, "ZAF": true,
+	"ZMB": true, "ZWE": true,
+}
+
+// WithValidation option makes CreateApplicant, AddBeneficiary and document
+// uploads validate request before it is sent, validation errors are returned
+// without request
+func WithValidation() Option {
+	return func(s *SumSub) {
+		s.validate = true
+	}
+}
+
+// IsCountry reports whether code is known ISO 3166-1 alpha-3 country code
+func IsCountry(code string) bool {
+	return countries[code]
+}
+
+// document types accepted by sumsub
+var docTypes = map[string]bool{
+	DocSetType_ID_CARD:                          true,
+	DocSetType_PASSPORT:                         true,
+	DocSetType_DRIVERS:                          true,
+	DocSetType_BANK_CARD:                        true,
+	DocSetType_UTILITY_BILL:                     true,
+	DocSetType_UTILITY_BILL2:                    true,
+	DocSetType_BANK_STATEMENT:                   true,
+	DocSetType_SNILS:                            true,
+	DocSetType_SELFIE:                           true,
+	DocSetType_VIDEO_SELFIE:                     true,
+	DocSetType_PROFILE_IMAGE:                    true,
+	DocSetType_ID_DOC_PHOTO:                     true,
+	DocSetType_AGREEMENT:                        true,
+	DocSetType_CONTRACT:                         true,
+	DocSetType_RESIDENCE_PERMIT:                 true,
+	DocSetType_EMPLOYMENT_CERTIFICATE:           true,
+	DocSetType_DRIVERS_TRANSLATION:              true,
+	DocSetType_INVESTOR_DOC:                     true,
+	DocSetType_VEHICLE_REGISTRATION_CERTIFICATE: true,
+	DocSetType_INCOME_SOURCE:                    true,
+	DocSetType_PAYMENT_METHOD:                   true,
+	DocSetType_COVID_VACCINATION_FORM:           true,
+	DocSetType_ARBITRARY_DOC:                    true,
+	DocSetType_OTHER:                            true,
+
+	DocSetType_COMPANY_DOC:            true,
+	DocSetType_INCORPORATION_CERT:     true,
+	DocSetType_INCORPORATION_ARTICLES: true,
+	DocSetType_SHAREHOLDER_REGISTRY:   true,
+	DocSetType_DIRECTORS_REGISTRY:     true,
+	DocSetType_STATE_REGISTRY:         true,
+	DocSetType_GOOD_STANDING_CERT:     true,
+	DocSetType_POWER_OF_ATTORNEY:      true,
+	DocSetType_TRUST_AGREEMENT:        true,
+	DocSetType_INFORMATION_STATEMENT:  true,
+	DocSetType_PROOF_OF_ADDRESS:       true,
+}
+
+// Validate checks document type, subtype, country and dates before upload
+func (md DocumentMetaData) Validate() error {
+	if md.IDDocType == "" {
+		return errors.New("document type is empty")
+	}
+	if !docTypes[md.IDDocType] {
+		return fmt.Errorf("unknown document type %s", md.IDDocType)
+	}
+
+	switch md.IDDocSubType {
+	case "", DocSetSubTypeFront, DocSetSubTypeBack:
+	default:
+		return fmt.Errorf("unknown document subtype %s", md.IDDocSubType)
+	}
+
+	if !IsCountry(md.Country) {
+		return fmt.Errorf("invalid document country %q, should be ISO 3166-1 alpha-3 code", md.Country)
+	}
+
+	dates := []struct {
+		name string
+		date Date
+	}{
+		{"issuedDate", md.IssuedDate},
+		{"validUntil", md.ValidUntil},
+		{"dob", md.DateOfBirth},
+		{"incorporatedOn", md.IncorporatedOn},
+	}
+	for _, d := range dates {
+		if _, err := d.date.Time(); err != nil {
+			return fmt.Errorf("invalid document %s %q, should be yyyy-mm-dd", d.name, d.date)
+		}
+	}
+
+	return nil
+}
+
+// idDocSetTypes are known doc set types, value is set of allowed document
+// types, nil means any type
+var idDocSetTypes = map[string]map[string]bool{
+	IDDocSetType_IDENTITY:            identityDocTypes,
+	IDDocSetType_IDENTITY2:           identityDocTypes,
+	IDDocSetType_IDENTITY3:           identityDocTypes,
+	IDDocSetType_IDENTITY4:           identityDocTypes,
+	IDDocSetType_SELFIE:              selfieDocTypes,
+	IDDocSetType_SELFIE2:             selfieDocTypes,
+	IDDocSetType_PROOF_OF_RESIDENCE:  nil,
+	IDDocSetType_PROOF_OF_RESIDENCE2: nil,
+	IDDocSetType_PAYMENT_METHODS:     nil,
+	IDDocSetType_APPLICANT_DATA:      nil,
+	IDDocSetType_PHONE_VERIFICATION:  nil,
+	IDDocSetType_EMAIL_VERIFICATION:  nil,
+	IDDocSetType_QUESTIONNAIRE:       nil,
+	IDDocSetType_E_KYC:               nil,
+	IDDocSetType_COMPANY:             nil,
+}
+
+var identityDocTypes = map[string]bool{
+	DocSetType_ID_CARD:          true,
+	DocSetType_PASSPORT:         true,
+	DocSetType_DRIVERS:          true,
+	DocSetType_RESIDENCE_PERMIT: true,
+}
+
+var selfieDocTypes = map[string]bool{
+	DocSetType_SELFIE:       true,
+	DocSetType_VIDEO_SELFIE: true,
+}
+
+// dataDocSetTypes are doc sets of the applicant data without documents
+var dataDocSetTypes = map[string]bool{
+	IDDocSetType_APPLICANT_DATA:     true,
+	IDDocSetType_PHONE_VERIFICATION: true,
+	IDDocSetType_EMAIL_VERIFICATION: true,
+	IDDocSetType_QUESTIONNAIRE:      true,
+	IDDocSetType_E_KYC:              true,
+}
+
+// Validate checks required fields, country codes, dates and required
+// documents of the applicant before creation
+func (a Applicant) Validate() error {
+	if a.ExternalUserID == "" {
+		return errors.New("applicant externalUserId is empty")
+	}
+
+	switch a.Type {
+	case "", ApplicantTypeIndividual:
+	case ApplicantTypeCompany:
+		if a.Info.CompanyInfo == nil {
+			return errors.New("company applicant info.companyInfo is empty")
+		}
+	default:
+		return fmt.Errorf("unknown applicant type %s", a.Type)
+	}
+
+	if a.Email != "" && !strings.Contains(a.Email, "@") {
+		return fmt.Errorf("invalid applicant email %q", a.Email)
+	}
+
+	if err := a.Info.Validate(); err != nil {
+		return fmt.Errorf("applicant info: %v", err)
+	}
+
+	if err := a.RequiredIdDocs.Validate(); err != nil {
+		return fmt.Errorf("applicant requiredIdDocs: %v", err)
+	}
+
+	return nil
+}
+
+// Validate checks country codes, dates and company info of the applicant
+// info, all fields are optional
+func (info ApplicantInfo) Validate() error {
+	countryFields := []struct {
+		name    string
+		country string
+	}{
+		{"country", info.Country},
+		{"nationality", info.Nationality},
+		{"countryOfBirth", info.CountryOfBirth},
+		{"taxResidenceCountry", info.TaxResidenceCountry},
+	}
+	for _, f := range countryFields {
+		if f.country != "" && !IsCountry(f.country) {
+			return fmt.Errorf("invalid %s %q, should be ISO 3166-1 alpha-3 code", f.name, f.country)
+		}
+	}
+
+	if _, err := info.DateOfBirth.Time(); err != nil {
+		return fmt.Errorf("invalid dob %q, should be yyyy-mm-dd", info.DateOfBirth)
+	}
+
+	for i, addr := range info.Addresses {
+		if addr.Country != "" && !IsCountry(addr.Country) {
+			return fmt.Errorf("invalid addresses[%d].country %q, should be ISO 3166-1 alpha-3 code", i, addr.Country)
+		}
+	}
+
+	if info.CompanyInfo != nil {
+		if err := info.CompanyInfo.Validate(); err != nil {
+			return fmt.Errorf("companyInfo: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks doc sets and combinations of the known document types, e.g.
+// selfie can't be required in the IDENTITY doc set. Unknown doc set and
+// document types are accepted, they can be custom sets of the level or types
+// added to the api later
+func (docs ApplicantRequiredIDDocs) Validate() error {
+	if docs.Country != "" && !IsCountry(docs.Country) {
+		return fmt.Errorf("invalid country %q, should be ISO 3166-1 alpha-3 code", docs.Country)
+	}
+
+	seen := make(map[string]bool)
+	for i, set := range docs.DocSets {
+		if set.IDDocSetType == "" {
+			return fmt.Errorf("docSets[%d]: doc set type is empty", i)
+		}
+
+		if seen[set.IDDocSetType] {
+			return fmt.Errorf("docSets[%d]: duplicate doc set type %s", i, set.IDDocSetType)
+		}
+		seen[set.IDDocSetType] = true
+
+		if len(set.Types) == 0 && !dataDocSetTypes[set.IDDocSetType] {
+			return fmt.Errorf("docSets[%d]: %s document types are empty", i, set.IDDocSetType)
+		}
+
+		allowed := idDocSetTypes[set.IDDocSetType]
+		for _, docType := range set.Types {
+			if docType == "" {
+				return fmt.Errorf("docSets[%d]: document type is empty", i)
+			}
+			if allowed != nil && docTypes[docType] && !allowed[docType] {
+				return fmt.Errorf("docSets[%d]: document type %s is not allowed in %s doc set", i, docType, set.IDDocSetType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks required fields of the company, country code, date of
+// incorporation and beneficiaries
+func (c CompanyInfo) Validate() error {
+	if c.CompanyName == "" {
+		return errors.New("companyName is empty")
+	}
+
+	if !IsCountry(c.Country) {
+		return fmt.Errorf("invalid country %q, should be ISO 3166-1 alpha-3 code", c.Country)
+	}
+
+	if _, err := c.IncorporatedOn.Time(); err != nil {
+		return fmt.Errorf("invalid incorporatedOn %q, should be yyyy-mm-dd", c.IncorporatedOn)
+	}
+
+	var shares float64
+	for i, b := range c.Beneficiaries {
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("beneficiaries[%d]: %v", i, err)
+		}
+		shares += b.ShareSize
+	}
+	if shares > 100 {
+		return fmt.Errorf("total share size of the beneficiaries is %g%%, more than 100%%", shares)
+	}
+
+	return nil
+}
+
+// Validate checks applicant id, types and share size of the beneficiary
+func (b Beneficiary) Validate() error {
+	if b.ApplicantID == "" {
+		return errors.New("beneficiary applicantId is empty")
+	}
+
+	for _, t := range b.Types {
+		switch t {
+		case BeneficiaryTypeUBO, BeneficiaryTypeShareholder, BeneficiaryTypeDirector, BeneficiaryTypeRepresentative:
+		default:
+			return fmt.Errorf("unknown beneficiary type %s", t)
+		}
+	}
+
+	if b.ShareSize < 0 || b.ShareSize > 100 {
+		return fmt.Errorf("invalid beneficiary share size %g, should be between 0 and 100", b.ShareSize)
+	}
+
+	return nil
+}
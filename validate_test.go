@@ -0,0 +1,160 @@
+package sumsub
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDocumentMetaDataValidate(t *testing.T) {
+	valid := DocumentMetaData{
+		IDDocType:    DocSetType_PASSPORT,
+		IDDocSubType: DocSetSubTypeFront,
+		Country:      "GBR",
+		IssuedDate:   "2015-03-01",
+		DateOfBirth:  "1990-01-31",
+	}
+	if err := valid.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	company := DocumentMetaData{IDDocType: DocSetType_INCORPORATION_CERT, Country: "DEU", RegistrationNumber: "HRB 12345", IncorporatedOn: "2015-03-01"}
+	if err := company.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	invalid := map[string]func(*DocumentMetaData){
+		"empty type":    func(md *DocumentMetaData) { md.IDDocType = "" },
+		"unknown type":  func(md *DocumentMetaData) { md.IDDocType = "INCOME SOURCE" },
+		"subtype":       func(md *DocumentMetaData) { md.IDDocSubType = "FRONT" },
+		"alpha-2":       func(md *DocumentMetaData) { md.Country = "GB" },
+		"unknown code":  func(md *DocumentMetaData) { md.Country = "XXX" },
+		"date format":   func(md *DocumentMetaData) { md.IssuedDate = "01.03.2015" },
+		"invalid month": func(md *DocumentMetaData) { md.DateOfBirth = "1990-13-01" },
+		"incorporated":  func(md *DocumentMetaData) { md.IncorporatedOn = "2015" },
+	}
+	for name, change := range invalid {
+		md := valid
+		change(&md)
+		if err := md.Validate(); err == nil {
+			t.Error("expected error for", name)
+		}
+	}
+}
+
+func TestApplicantValidate(t *testing.T) {
+	valid := Applicant{
+		ExternalUserID: "user-1",
+		Email:          "user@example.com",
+		Info: ApplicantInfo{
+			Country:     "GBR",
+			DateOfBirth: "1990-01-31",
+			Addresses:   []Address{{Country: "DEU"}},
+		},
+		RequiredIdDocs: ApplicantRequiredIDDocs{
+			DocSets: []ApplicantDoc{
+				{IDDocSetType: IDDocSetType_IDENTITY, Types: []string{DocSetType_PASSPORT, DocSetType_ID_CARD}},
+				{IDDocSetType: IDDocSetType_SELFIE, Types: []string{DocSetType_SELFIE}},
+				{IDDocSetType: IDDocSetType_APPLICANT_DATA, Fields: []string{"firstName"}},
+			},
+		},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	custom := valid
+	custom.RequiredIdDocs.DocSets = append([]ApplicantDoc{
+		{IDDocSetType: "TRAVEL_DOCS", Types: []string{"VISA", DocSetType_PASSPORT}},
+		{IDDocSetType: IDDocSetType_IDENTITY2, Types: []string{"NATIONAL_ID"}},
+	}, valid.RequiredIdDocs.DocSets...)
+	if err := custom.Validate(); err != nil {
+		t.Error("unknown doc set and document types should be accepted:", err)
+	}
+
+	invalid := map[string]func(*Applicant){
+		"external user id": func(a *Applicant) { a.ExternalUserID = "" },
+		"type":             func(a *Applicant) { a.Type = "person" },
+		"company info":     func(a *Applicant) { a.Type = ApplicantTypeCompany },
+		"email":            func(a *Applicant) { a.Email = "user" },
+		"country":          func(a *Applicant) { a.Info.Country = "GB" },
+		"dob":              func(a *Applicant) { a.Info.DateOfBirth = "31.01.1990" },
+		"address country":  func(a *Applicant) { a.Info.Addresses[0].Country = "DE" },
+		"doc set type":     func(a *Applicant) { a.RequiredIdDocs.DocSets[0].IDDocSetType = "" },
+		"empty doc types":  func(a *Applicant) { a.RequiredIdDocs.DocSets[0].Types = nil },
+		"doc type":         func(a *Applicant) { a.RequiredIdDocs.DocSets[0].Types = []string{""} },
+		"combination":      func(a *Applicant) { a.RequiredIdDocs.DocSets[0].Types = []string{DocSetType_SELFIE} },
+		"duplicate set": func(a *Applicant) {
+			a.RequiredIdDocs.DocSets = append(a.RequiredIdDocs.DocSets, a.RequiredIdDocs.DocSets[1])
+		},
+	}
+	for name, change := range invalid {
+		a := valid
+		a.Info.Addresses = []Address{{Country: "DEU"}}
+		a.RequiredIdDocs.DocSets = append([]ApplicantDoc(nil), valid.RequiredIdDocs.DocSets...)
+		change(&a)
+		if err := a.Validate(); err == nil {
+			t.Error("expected error for", name)
+		}
+	}
+}
+
+func TestCompanyInfoValidate(t *testing.T) {
+	valid := CompanyInfo{
+		CompanyName:    "Company Ltd",
+		Country:        "GBR",
+		IncorporatedOn: "2010-05-01",
+		Beneficiaries: []Beneficiary{
+			{ApplicantID: "a1", Types: []string{BeneficiaryTypeUBO}, ShareSize: 60},
+			{ApplicantID: "a2", Types: []string{BeneficiaryTypeDirector}},
+		},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	invalid := map[string]func(*CompanyInfo){
+		"name":         func(c *CompanyInfo) { c.CompanyName = "" },
+		"country":      func(c *CompanyInfo) { c.Country = "" },
+		"incorporated": func(c *CompanyInfo) { c.IncorporatedOn = "2010" },
+		"applicant":    func(c *CompanyInfo) { c.Beneficiaries[1].ApplicantID = "" },
+		"type":         func(c *CompanyInfo) { c.Beneficiaries[1].Types = []string{"owner"} },
+		"share":        func(c *CompanyInfo) { c.Beneficiaries[1].ShareSize = 120 },
+		"total share":  func(c *CompanyInfo) { c.Beneficiaries[1].ShareSize = 50 },
+	}
+	for name, change := range invalid {
+		c := valid
+		c.Beneficiaries = append([]Beneficiary(nil), valid.Beneficiaries...)
+		change(&c)
+		if err := c.Validate(); err == nil {
+			t.Error("expected error for", name)
+		}
+	}
+}
+
+func TestWithValidation(t *testing.T) {
+	s := &SumSub{}
+	WithReadOnly()(s)
+
+	if err := s.CreateApplicant(&Applicant{}); err != ErrReadOnly {
+		t.Error("applicant should not be validated by default, got", err)
+	}
+
+	doc := Document{
+		Metadata:    DocumentMetaData{IDDocType: "NEW_DOC_TYPE", Country: "GBR"},
+		Content:     strings.NewReader("content"),
+		ContentType: "image/jpeg",
+	}
+	if _, err := s.UploadDocument("id", doc); err != ErrReadOnly {
+		t.Error("document of unknown type should not be validated by default, got", err)
+	}
+
+	WithValidation()(s)
+	if err := s.CreateApplicant(&Applicant{}); err == nil || err == ErrReadOnly {
+		t.Error("expected validation error, got", err)
+	}
+
+	doc.Content = strings.NewReader("content")
+	if _, err := s.UploadDocument("id", doc); err == nil || err == ErrReadOnly {
+		t.Error("expected document validation error, got", err)
+	}
+}
@@ -0,0 +1,56 @@
+package sumsub
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxVideoSize is limit of the video selfie size
+	DefaultMaxVideoSize = 100 << 20
+
+	// DefaultVideoTimeout is timeout of the video selfie upload
+	DefaultVideoTimeout = 10 * time.Minute
+)
+
+// VideoOptions of the video selfie upload
+type VideoOptions struct {
+	// MaxSize of the video in bytes, DefaultMaxVideoSize is used if zero
+	MaxSize int64
+
+	// Timeout of the whole upload, DefaultVideoTimeout is used if zero
+	Timeout time.Duration
+
+	// ContentType of the video, e.g. video/mp4, it is detected if empty
+	ContentType string
+
+	// FileName of the video, it is generated if empty
+	FileName string
+}
+
+// AddVideoSelfie uploads video selfie to the applicant, video is streamed in
+// single multipart request with own timeout and is not buffered in memory.
+// Upload is not resumable, failed upload should be started again. Document
+// type is set to VIDEO_SELFIE, country should be specified in metadata
+func (s *SumSub) AddVideoSelfie(id string, metadata DocumentMetaData, video io.Reader, opts VideoOptions) (DocumentResult, error) {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxVideoSize
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultVideoTimeout
+	}
+
+	metadata.IDDocType = DocSetType_VIDEO_SELFIE
+	metadata.IDDocSubType = ""
+
+	doc := Document{
+		Metadata:    metadata,
+		Content:     video,
+		FileName:    opts.FileName,
+		ContentType: opts.ContentType,
+		MaxSize:     opts.MaxSize,
+	}
+
+	return s.uploadDocument(id, doc, &http.Client{Timeout: opts.Timeout})
+}
@@ -0,0 +1,66 @@
+package sumsub
+
+import (
+	"context"
+	"time"
+)
+
+// PollOptions of the WaitForReview
+type PollOptions struct {
+	// MinInterval is delay before the second request, it is doubled for each
+	// next request up to MaxInterval, defaults are 2s and 1m
+	MinInterval time.Duration
+	MaxInterval time.Duration
+
+	// OnStatus is called with each received status that is not completed
+	OnStatus func(ApplicantStatus)
+}
+
+func (opts *PollOptions) setDefaults() {
+	if opts.MinInterval <= 0 {
+		opts.MinInterval = 2 * time.Second
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = time.Minute
+	}
+	if opts.MaxInterval < opts.MinInterval {
+		opts.MaxInterval = opts.MinInterval
+	}
+}
+
+// WaitForReview polls status of the applicant until review is completed or ctx
+// is done, the last received status is returned with ctx error on timeout
+// GET /resources/applicants/{applicantId}/status
+func (s *SumSub) WaitForReview(ctx context.Context, id string, opts PollOptions) (status ApplicantStatus, err error) {
+	opts.setDefaults()
+
+	interval := opts.MinInterval
+	for {
+		var next ApplicantStatus
+		if err := s.Do(ctx, "GET", "resources/applicants/"+id+"/status", nil, nil, &next); err != nil {
+			if ctx.Err() != nil {
+				return status, ctx.Err()
+			}
+			return status, err
+		}
+		status = next
+
+		if status.IsCompleted() {
+			return status, nil
+		}
+
+		if opts.OnStatus != nil {
+			opts.OnStatus(status)
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return status, ctx.Err()
+		}
+
+		if interval *= 2; interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
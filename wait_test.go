@@ -0,0 +1,51 @@
+package sumsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForReview(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := ReviewStatusPending
+		if atomic.AddInt32(&requests, 1) >= 3 {
+			status = ReviewStatusCompleted
+		}
+		fmt.Fprintf(w, `{"applicantId": "id", "reviewStatus": %q, "reviewResult": {"reviewAnswer": "GREEN"}}`, status)
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	s := &SumSub{url: *u, token: "token", tokenExpired: time.Now().Add(time.Hour)}
+
+	var pending int
+	opts := PollOptions{
+		MinInterval: time.Millisecond,
+		MaxInterval: 2 * time.Millisecond,
+		OnStatus:    func(ApplicantStatus) { pending++ },
+	}
+
+	status, err := s.WaitForReview(context.Background(), "id", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.IsCompleted() || atomic.LoadInt32(&requests) != 3 || pending != 2 {
+		t.Error("unexpected result", status.ReviewStatus, requests, pending)
+	}
+
+	atomic.StoreInt32(&requests, -100)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	status, err = s.WaitForReview(ctx, "id", opts)
+	if err != context.DeadlineExceeded || status.ReviewStatus != ReviewStatusPending {
+		t.Error("expected deadline error with last status", status.ReviewStatus, err)
+	}
+}
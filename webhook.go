@@ -0,0 +1,209 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// webhook types
+const (
+	WebhookApplicantCreated             = "applicantCreated"
+	WebhookApplicantPending             = "applicantPending"
+	WebhookApplicantReviewed            = "applicantReviewed"
+	WebhookApplicantOnHold              = "applicantOnHold"
+	WebhookApplicantPrechecked          = "applicantPrechecked"
+	WebhookApplicantPersonalInfoChanged = "applicantPersonalInfoChanged"
+	WebhookApplicantReset               = "applicantReset"
+	WebhookApplicantLevelChanged        = "applicantLevelChanged"
+	WebhookApplicantDeleted             = "applicantDeleted"
+	WebhookVideoIdentStatusChanged      = "videoIdentStatusChanged"
+	WebhookApplicantActionPending       = "applicantActionPending"
+	WebhookApplicantActionReviewed      = "applicantActionReviewed"
+	WebhookApplicantActionOnHold        = "applicantActionOnHold"
+	WebhookApplicantAMLHitsFound        = "applicantAmlHitsFound"
+)
+
+// Webhook is payload of any webhook type, use type switch to get specific
+// payload, e.g. *ApplicantReviewedWebhook
+type Webhook interface {
+	Payload() *WebhookPayload
+}
+
+// WebhookPayload is common part of the webhook payloads, it is returned by
+// ParseWebhook for unknown webhook types
+type WebhookPayload struct {
+	Type           string `json:"type"`
+	ApplicantID    string `json:"applicantId"`
+	InspectionID   string `json:"inspectionId"`
+	CorrelationID  string `json:"correlationId"`
+	ExternalUserID string `json:"externalUserId,omitempty"`
+	LevelName      string `json:"levelName,omitempty"`
+	ApplicantType  string `json:"applicantType,omitempty"`
+	ClientID       string `json:"clientId,omitempty"`
+	SandboxMode    bool   `json:"sandboxMode"`
+	ReviewStatus   string `json:"reviewStatus,omitempty"`
+	CreatedAt      Time   `json:"createdAt"`
+	CreatedAtMs    Time   `json:"createdAtMs,omitempty"`
+
+	// ApplicantMemberOf are company applicants the applicant is beneficiary of
+	ApplicantMemberOf []ApplicantMembership `json:"applicantMemberOf,omitempty"`
+
+	// RawJSON is webhook body as it is received, it gives access to the
+	// fields which are not declared in the structs
+	RawJSON json.RawMessage `json:"-"`
+}
+
+// Payload returns common part of the webhook
+func (p *WebhookPayload) Payload() *WebhookPayload {
+	return p
+}
+
+// ApplicantCreatedWebhook is sent when applicant is created
+type ApplicantCreatedWebhook struct {
+	WebhookPayload
+}
+
+// ApplicantPendingWebhook is sent when applicant is submitted to review
+type ApplicantPendingWebhook struct {
+	WebhookPayload
+}
+
+// ApplicantReviewedWebhook is sent when applicant review is completed
+type ApplicantReviewedWebhook struct {
+	WebhookPayload
+	ReviewResult ReviewResult `json:"reviewResult"`
+}
+
+// ApplicantOnHoldWebhook is sent when review is on hold, e.g. waiting for
+// compliance officer decision
+type ApplicantOnHoldWebhook struct {
+	WebhookPayload
+	ReviewResult ReviewResult `json:"reviewResult"`
+}
+
+// ApplicantPrecheckedWebhook is sent when primary data processing is completed
+type ApplicantPrecheckedWebhook struct {
+	WebhookPayload
+}
+
+// ApplicantPersonalInfoChangedWebhook is sent when applicant info or
+// documents data is changed
+type ApplicantPersonalInfoChangedWebhook struct {
+	WebhookPayload
+}
+
+// ApplicantResetWebhook is sent when applicant is reset
+type ApplicantResetWebhook struct {
+	WebhookPayload
+}
+
+// ApplicantLevelChangedWebhook is sent when applicant level is changed
+type ApplicantLevelChangedWebhook struct {
+	WebhookPayload
+}
+
+// ApplicantDeletedWebhook is sent when applicant is deleted
+type ApplicantDeletedWebhook struct {
+	WebhookPayload
+}
+
+// VideoIdentStatusChangedWebhook is sent when video identification status is
+// changed
+type VideoIdentStatusChangedWebhook struct {
+	WebhookPayload
+	VideoIdentReviewStatus string       `json:"videoIdentReviewStatus"`
+	ReviewResult           ReviewResult `json:"reviewResult"`
+}
+
+// ApplicantActionWebhook is payload of the applicant action webhooks,
+// ExternalApplicantActionID is externalActionId the action is created with
+type ApplicantActionWebhook struct {
+	WebhookPayload
+	ApplicantActionID         string       `json:"applicantActionId"`
+	ExternalApplicantActionID string       `json:"externalApplicantActionId"`
+	ReviewResult              ReviewResult `json:"reviewResult"`
+}
+
+// IsPass reports whether action review is completed with GREEN answer
+func (w *ApplicantActionWebhook) IsPass() bool {
+	return w.ReviewStatus == ReviewStatusCompleted && w.ReviewResult.ReviewAnswer == ReviewResultGREEN
+}
+
+// ApplicantActionPendingWebhook is sent when applicant action is submitted to
+// review
+type ApplicantActionPendingWebhook struct {
+	ApplicantActionWebhook
+}
+
+// ApplicantActionReviewedWebhook is sent when applicant action review is
+// completed
+type ApplicantActionReviewedWebhook struct {
+	ApplicantActionWebhook
+}
+
+// ApplicantActionOnHoldWebhook is sent when applicant action review is on hold
+type ApplicantActionOnHoldWebhook struct {
+	ApplicantActionWebhook
+}
+
+// ApplicantAMLHitsFoundWebhook is sent when ongoing monitoring finds new
+// AML hits of the applicant
+type ApplicantAMLHitsFoundWebhook struct {
+	WebhookPayload
+	Hits AMLHits `json:"hits,omitempty"`
+}
+
+// webhookTypes creates empty payload by webhook type
+var webhookTypes = map[string]func() Webhook{
+	WebhookApplicantCreated:             func() Webhook { return new(ApplicantCreatedWebhook) },
+	WebhookApplicantPending:             func() Webhook { return new(ApplicantPendingWebhook) },
+	WebhookApplicantReviewed:            func() Webhook { return new(ApplicantReviewedWebhook) },
+	WebhookApplicantOnHold:              func() Webhook { return new(ApplicantOnHoldWebhook) },
+	WebhookApplicantPrechecked:          func() Webhook { return new(ApplicantPrecheckedWebhook) },
+	WebhookApplicantPersonalInfoChanged: func() Webhook { return new(ApplicantPersonalInfoChangedWebhook) },
+	WebhookApplicantReset:               func() Webhook { return new(ApplicantResetWebhook) },
+	WebhookApplicantLevelChanged:        func() Webhook { return new(ApplicantLevelChangedWebhook) },
+	WebhookApplicantDeleted:             func() Webhook { return new(ApplicantDeletedWebhook) },
+	WebhookVideoIdentStatusChanged:      func() Webhook { return new(VideoIdentStatusChangedWebhook) },
+	WebhookApplicantActionPending:       func() Webhook { return new(ApplicantActionPendingWebhook) },
+	WebhookApplicantActionReviewed:      func() Webhook { return new(ApplicantActionReviewedWebhook) },
+	WebhookApplicantActionOnHold:        func() Webhook { return new(ApplicantActionOnHoldWebhook) },
+	WebhookApplicantAMLHitsFound:        func() Webhook { return new(ApplicantAMLHitsFoundWebhook) },
+}
+
+// ParseWebhook decodes webhook payload into the struct of its type, payload
+// of unknown type is returned as *WebhookPayload
+func ParseWebhook(data []byte) (Webhook, error) {
+	var payload WebhookPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Type == "" {
+		return nil, errors.New("webhook type is empty")
+	}
+
+	raw := append(json.RawMessage(nil), data...)
+
+	newWebhook, ok := webhookTypes[payload.Type]
+	if !ok {
+		payload.RawJSON = raw
+		return &payload, nil
+	}
+
+	webhook := newWebhook()
+	if err := json.Unmarshal(data, webhook); err != nil {
+		return nil, err
+	}
+	webhook.Payload().RawJSON = raw
+
+	return webhook, nil
+}
+
+// ResendWebhook requests re-delivery of the latest review webhook of the
+// applicant, e.g. when webhook consumer was unavailable and
+// Review.NotificationFailureCnt is increasing
+// POST /resources/applicants/{applicantId}/status/resendWebhook
+func (s *SumSub) ResendWebhook(id string) error {
+	resp, err := s.do("POST", "resources/applicants/"+id+"/status/resendWebhook")
+	return handleResponse(resp, err)
+}
@@ -0,0 +1,66 @@
+package sumsub
+
+import "testing"
+
+func TestParseWebhook(t *testing.T) {
+	data := `{
+		"applicantId": "5cb56e8e0a975a35f333cb83",
+		"inspectionId": "5cb56e8e0a975a35f333cb84",
+		"correlationId": "req-a260b669-4f14-4bb5-a4c5-ac0218acb9a4",
+		"externalUserId": "externalUserId",
+		"levelName": "basic-kyc-level",
+		"type": "applicantReviewed",
+		"reviewResult": {"reviewAnswer": "RED", "rejectLabels": ["BAD_SELFIE"], "reviewRejectType": "RETRY"},
+		"reviewStatus": "completed",
+		"createdAtMs": "2020-02-21 13:23:19.321"
+	}`
+
+	webhook, err := ParseWebhook([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reviewed, ok := webhook.(*ApplicantReviewedWebhook)
+	if !ok {
+		t.Fatalf("wrong webhook type %T", webhook)
+	}
+	if reviewed.ApplicantID != "5cb56e8e0a975a35f333cb83" || reviewed.ReviewResult.ReviewAnswer != ReviewResultRED {
+		t.Errorf("wrong payload %+v", reviewed)
+	}
+	if reviewed.Payload().CreatedAtMs.IsZero() {
+		t.Error("createdAtMs is not parsed")
+	}
+	if string(reviewed.RawJSON) != data {
+		t.Error("raw json is not kept", string(reviewed.RawJSON))
+	}
+
+	webhook, err = ParseWebhook([]byte(`{
+		"type": "applicantActionReviewed",
+		"applicantActionId": "a1",
+		"externalApplicantActionId": "card-1",
+		"reviewStatus": "completed",
+		"reviewResult": {"reviewAnswer": "GREEN"}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	action, ok := webhook.(*ApplicantActionReviewedWebhook)
+	if !ok || action.ApplicantActionID != "a1" || action.ExternalApplicantActionID != "card-1" {
+		t.Fatalf("wrong action webhook %T %+v", webhook, webhook)
+	}
+	if !action.IsPass() {
+		t.Error("action should pass", action.ReviewResult)
+	}
+
+	webhook, err = ParseWebhook([]byte(`{"type": "somethingNew", "applicantId": "id"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := webhook.(*WebhookPayload); !ok || p.ApplicantID != "id" {
+		t.Errorf("unknown webhook should be returned as payload, got %T", webhook)
+	}
+
+	if _, err := ParseWebhook([]byte(`{}`)); err == nil {
+		t.Error("expected error for empty type")
+	}
+}
@@ -0,0 +1,258 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// WebhookQueue keeps verified webhook payloads until they are processed, it
+// can be implemented on top of message broker or database to survive restarts
+type WebhookQueue interface {
+	// Enqueue adds raw webhook payload to the queue
+	Enqueue(payload []byte) error
+
+	// Dequeue blocks until payload is available or ctx is done, payload is
+	// kept in the queue until Ack or Nack is called with the returned id
+	Dequeue(ctx context.Context) (id string, payload []byte, err error)
+
+	// Ack removes processed payload from the queue
+	Ack(id string) error
+
+	// Nack returns payload to the queue to be dequeued again, it should be
+	// placed after already queued payloads, so failing payload does not block
+	// the others
+	Nack(id string) error
+}
+
+// MemoryWebhookQueue is in-memory WebhookQueue with limited capacity
+type MemoryWebhookQueue struct {
+	mu       sync.Mutex
+	size     int
+	seq      int
+	ready    []queuedPayload
+	inflight map[string][]byte
+
+	// notify wakes up waiting Dequeue
+	notify chan struct{}
+}
+
+type queuedPayload struct {
+	id      string
+	payload []byte
+}
+
+// NewMemoryWebhookQueue creates queue for size payloads, including dequeued
+// but not acknowledged ones
+func NewMemoryWebhookQueue(size int) *MemoryWebhookQueue {
+	return &MemoryWebhookQueue{
+		size:     size,
+		inflight: make(map[string][]byte),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// Enqueue adds payload, ErrQueueFull is returned if queue is full
+func (q *MemoryWebhookQueue) Enqueue(payload []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.ready)+len(q.inflight) >= q.size {
+		return ErrQueueFull
+	}
+
+	q.seq++
+	q.ready = append(q.ready, queuedPayload{id: strconv.Itoa(q.seq), payload: payload})
+	q.wakeup()
+
+	return nil
+}
+
+// Dequeue waits for payload
+func (q *MemoryWebhookQueue) Dequeue(ctx context.Context) (string, []byte, error) {
+	for {
+		q.mu.Lock()
+		if len(q.ready) > 0 {
+			p := q.ready[0]
+			q.ready = q.ready[1:]
+			q.inflight[p.id] = p.payload
+			if len(q.ready) > 0 {
+				q.wakeup()
+			}
+			q.mu.Unlock()
+
+			return p.id, p.payload, nil
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+			return "", nil, ctx.Err()
+		}
+	}
+}
+
+// Ack removes payload
+func (q *MemoryWebhookQueue) Ack(id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.inflight, id)
+	return nil
+}
+
+// Nack returns payload to the tail of the queue
+func (q *MemoryWebhookQueue) Nack(id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	payload, ok := q.inflight[id]
+	if !ok {
+		return nil
+	}
+
+	delete(q.inflight, id)
+	q.ready = append(q.ready, queuedPayload{id: id, payload: payload})
+	q.wakeup()
+
+	return nil
+}
+
+// wakeup signals waiting Dequeue, it is called with locked mutex
+func (q *MemoryWebhookQueue) wakeup() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// ErrQueueFull is returned by MemoryWebhookQueue if there is no space for
+// payload, sumsub retries such webhooks
+var ErrQueueFull = errors.New("webhook queue is full")
+
+// RetryOptions of the queued webhooks processing
+type RetryOptions struct {
+	// MaxAttempts to process webhook, default is 5
+	MaxAttempts int
+
+	// MinBackoff is delay before the second attempt, it is doubled for each
+	// next attempt up to MaxBackoff, defaults are 1s and 1m
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// OnFailure is called when all attempts are failed, e.g. to move webhook
+	// to the dead letter storage. Webhook is acknowledged if OnFailure returns
+	// nil, otherwise it is returned to the tail of the queue and processed
+	// again. If OnFailure is not set, failed webhook is logged and dropped
+	OnFailure func(Webhook, error) error
+}
+
+func (opts *RetryOptions) setDefaults() {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 5
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = time.Minute
+	}
+}
+
+// backoff returns delay after the failed attempt, attempts are counted from 1
+func (opts RetryOptions) backoff(attempt int) time.Duration {
+	d := opts.MinBackoff
+	for i := 1; i < attempt && d < opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > opts.MaxBackoff {
+		d = opts.MaxBackoff
+	}
+
+	return d
+}
+
+// UseQueue makes handler enqueue verified webhooks and respond to sumsub
+// immediately, webhooks are processed by RunQueue
+func (h *WebhookHandler) UseQueue(q WebhookQueue) {
+	h.queue = q
+}
+
+// RunQueue processes queued webhooks until ctx is done, failed callbacks are
+// retried with exponential backoff. Webhook is acknowledged when it is
+// processed, webhook which is being processed when ctx is done is returned to
+// the queue
+func (h *WebhookHandler) RunQueue(ctx context.Context, opts RetryOptions) error {
+	opts.setDefaults()
+
+	for {
+		id, payload, err := h.queue.Dequeue(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if err := h.handleQueued(ctx, payload, opts); err != nil {
+			if err := h.queue.Nack(id); err != nil {
+				return err
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+
+		if err := h.queue.Ack(id); err != nil {
+			return err
+		}
+	}
+}
+
+// handleQueued processes queued payload, error means payload should be
+// returned to the queue
+func (h *WebhookHandler) handleQueued(ctx context.Context, payload []byte, opts RetryOptions) error {
+	webhook, err := ParseWebhook(payload)
+	if err != nil {
+		// payload is verified before it is enqueued, it never becomes valid
+		log.Errorf("queued webhook is not parsed: %v", err)
+		return nil
+	}
+
+	err = h.retry(ctx, webhook, opts)
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+
+	log.Errorf("webhook %s of applicant %s: %v", webhook.Payload().Type, webhook.Payload().ApplicantID, err)
+	if opts.OnFailure == nil {
+		return nil
+	}
+
+	return opts.OnFailure(webhook, err)
+}
+
+// retry processes webhook up to opts.MaxAttempts times
+func (h *WebhookHandler) retry(ctx context.Context, webhook Webhook, opts RetryOptions) (err error) {
+	for attempt := 1; attempt <= opts.MaxAttempts; attempt++ {
+		if err = h.process(webhook); err == nil {
+			return nil
+		}
+
+		if attempt == opts.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-time.After(opts.backoff(attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,173 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookQueue(t *testing.T) {
+	h := NewWebhookHandler("")
+	h.UseQueue(NewMemoryWebhookQueue(1))
+
+	var attempts int
+	done := make(chan struct{})
+	h.OnApplicantReviewed(func(*ApplicantReviewedWebhook) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("database is unavailable")
+		}
+		close(done)
+		return nil
+	})
+
+	body := `{"type": "applicantReviewed", "applicantId": "id"}`
+	for i, code := range []int{http.StatusOK, http.StatusInternalServerError} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", strings.NewReader(body)))
+		if w.Code != code {
+			t.Errorf("request %d: expected status %d, got %d", i, code, w.Code)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go h.RunQueue(ctx, RetryOptions{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("webhook is not processed, attempts:", attempts)
+	}
+}
+
+func TestWebhookQueueCancel(t *testing.T) {
+	q := NewMemoryWebhookQueue(1)
+	h := NewWebhookHandler("")
+	h.UseQueue(q)
+
+	called := make(chan struct{}, 1)
+	h.OnApplicantReviewed(func(*ApplicantReviewedWebhook) error {
+		called <- struct{}{}
+		return errors.New("database is unavailable")
+	})
+
+	if err := q.Enqueue([]byte(`{"type": "applicantReviewed", "applicantId": "id"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error)
+	go func() {
+		stopped <- h.RunQueue(ctx, RetryOptions{MinBackoff: time.Hour})
+	}()
+
+	<-called
+	cancel()
+	if err := <-stopped; err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	id, payload, err := q.Dequeue(ctx)
+	if err != nil || !strings.Contains(string(payload), "applicantReviewed") {
+		t.Fatal("webhook is lost after cancel", err)
+	}
+
+	q.Ack(id)
+	if _, _, err := q.Dequeue(ctx); err != context.DeadlineExceeded {
+		t.Error("acknowledged webhook is dequeued again", err)
+	}
+}
+
+func TestWebhookQueueFailure(t *testing.T) {
+	q := NewMemoryWebhookQueue(1)
+	h := NewWebhookHandler("")
+	h.UseQueue(q)
+	h.OnApplicantReviewed(func(*ApplicantReviewedWebhook) error {
+		return errors.New("database is unavailable")
+	})
+
+	q.Enqueue([]byte(`{"type": "applicantReviewed", "applicantId": "id"}`))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int
+	failures := make(chan struct{}, 2)
+	opts := RetryOptions{MaxAttempts: 2, MinBackoff: time.Millisecond}
+	opts.OnFailure = func(w Webhook, err error) error {
+		failures <- struct{}{}
+		if calls++; calls == 1 {
+			return errors.New("dead letter storage is unavailable")
+		}
+		return nil
+	}
+	go h.RunQueue(ctx, opts)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-failures:
+		case <-time.After(time.Second):
+			t.Fatal("webhook is not returned to the queue after failure")
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if err := q.Enqueue([]byte(`{}`)); err != nil {
+		t.Error("webhook handled by OnFailure is not acknowledged", err)
+	}
+}
+
+func TestWebhookQueueFailingPayload(t *testing.T) {
+	failures := map[string]func(Webhook, error) error{
+		"without OnFailure": nil,
+		"OnFailure error":   func(Webhook, error) error { return errors.New("dead letter storage is unavailable") },
+	}
+	for name, onFailure := range failures {
+		q := NewMemoryWebhookQueue(2)
+		h := NewWebhookHandler("")
+		h.UseQueue(q)
+
+		done := make(chan struct{})
+		h.OnApplicantReviewed(func(w *ApplicantReviewedWebhook) error {
+			if w.ApplicantID == "bad" {
+				return errors.New("invalid applicant")
+			}
+			close(done)
+			return nil
+		})
+
+		q.Enqueue([]byte(`{"type": "applicantReviewed", "applicantId": "bad"}`))
+		q.Enqueue([]byte(`{"type": "applicantReviewed", "applicantId": "good"}`))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go h.RunQueue(ctx, RetryOptions{MaxAttempts: 2, MinBackoff: time.Millisecond, OnFailure: onFailure})
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Error(name, ": webhook is blocked by failing one")
+		}
+		cancel()
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	opts := RetryOptions{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for attempt, expected := range []time.Duration{0, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if attempt == 0 {
+			continue
+		}
+		if d := opts.backoff(attempt); d != expected {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, expected, d)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package sumsub
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WebhookStore keeps keys of the processed webhooks to skip duplicates
+// delivered by sumsub retries, it can be implemented on top of Redis or SQL
+// database to share state between instances
+type WebhookStore interface {
+	// Exists reports whether webhook with the key is already processed
+	Exists(key string) (bool, error)
+
+	// Add marks webhook with the key as processed
+	Add(key string) error
+}
+
+// WebhookKey identifies webhook delivery by applicant id, type, correlation
+// id and creation time with full precision of the payload, e.g. milliseconds
+// of createdAtMs
+func WebhookKey(w Webhook) string {
+	p := w.Payload()
+
+	createdAt := p.CreatedAtMs
+	if createdAt.IsZero() && createdAt.Raw == "" {
+		createdAt = p.CreatedAt
+	}
+
+	ts := createdAt.Raw
+	if !createdAt.IsZero() {
+		ts = strconv.FormatInt(createdAt.UnixNano(), 10)
+	}
+
+	return strings.Join([]string{p.ApplicantID, p.Type, p.CorrelationID, ts}, "|")
+}
+
+// MemoryWebhookStore is in-memory WebhookStore, keys are forgotten after ttl
+type MemoryWebhookStore struct {
+	ttl time.Duration
+
+	mu   sync.Mutex
+	keys map[string]time.Time
+}
+
+// NewMemoryWebhookStore creates store which keeps keys for ttl, sumsub
+// retries webhooks during several hours, so ttl should be at least a day
+func NewMemoryWebhookStore(ttl time.Duration) *MemoryWebhookStore {
+	return &MemoryWebhookStore{
+		ttl:  ttl,
+		keys: make(map[string]time.Time),
+	}
+}
+
+// Exists reports whether key is added and not expired
+func (s *MemoryWebhookStore) Exists(key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expires, ok := s.keys[key]
+	return ok && time.Now().Before(expires), nil
+}
+
+// Add key to the store, expired keys are removed
+func (s *MemoryWebhookStore) Add(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, expires := range s.keys {
+		if !now.Before(expires) {
+			delete(s.keys, k)
+		}
+	}
+
+	s.keys[key] = now.Add(s.ttl)
+	return nil
+}
@@ -0,0 +1,45 @@
+package sumsub
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryWebhookStore(t *testing.T) {
+	s := NewMemoryWebhookStore(time.Hour)
+	if exists, _ := s.Exists("key"); exists {
+		t.Error("unexpected key")
+	}
+
+	s.Add("key")
+	if exists, _ := s.Exists("key"); !exists {
+		t.Error("key is not found")
+	}
+
+	s.keys["key"] = time.Now().Add(-time.Second)
+	if exists, _ := s.Exists("key"); exists {
+		t.Error("expired key is found")
+	}
+
+	s.Add("other")
+	if _, ok := s.keys["key"]; ok {
+		t.Error("expired key is not removed")
+	}
+}
+
+func TestWebhookKey(t *testing.T) {
+	key := func(createdAtMs string) string {
+		w, err := ParseWebhook([]byte(`{"type": "applicantPending", "applicantId": "id", "correlationId": "c1", "createdAt": "2021-06-01 12:00:00", "createdAtMs": "` + createdAtMs + `"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return WebhookKey(w)
+	}
+
+	if key("2021-06-01 12:00:00.001") == key("2021-06-01 12:00:00.002") {
+		t.Error("webhooks created 1ms apart have the same key")
+	}
+	if key("2021-06-01 12:00:00.001") != key("2021-06-01 12:00:00.001") {
+		t.Error("redelivered webhook has different key")
+	}
+}